@@ -0,0 +1,102 @@
+package dnbclient
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/struki84/dnbclient/api_response"
+)
+
+// APIError is returned when the D&B Direct+ API responds with a non 200
+// status code. It wraps ErrRequestFailed, and ErrNotEntitled when the account
+// lacks the entitlement, and can be inspected with errors.As.
+type APIError struct {
+	// HTTP status code of the response
+	StatusCode int
+
+	// D&B error code, e.g. 10001
+	ErrorCode string
+
+	// D&B error message
+	Message string
+
+	// Error description returned by the authentication endpoint
+	Description string
+
+	// Transaction ID of the failed request
+	TransactionID string
+
+	// Endpoint the request was sent to, e.g. CriteriaSearchURL
+	Endpoint string
+
+	// Whether repeating the request may succeed
+	Retryable bool
+
+	// Raw response body
+	Body []byte
+}
+
+func (apiError *APIError) Error() string {
+	message := apiError.Message
+
+	if message == "" {
+		message = apiError.Description
+	}
+
+	if message == "" {
+		message = apiError.ErrorCode
+	}
+
+	if message == "" {
+		message = strconv.Itoa(apiError.StatusCode)
+	}
+
+	return ErrRequestFailed.Error() + ", " + message
+}
+
+func (apiError *APIError) Unwrap() []error {
+	if apiError.NotEntitled() {
+		return []error{ErrRequestFailed, ErrNotEntitled}
+	}
+
+	return []error{ErrRequestFailed}
+}
+
+// NotEntitled reports whether the request failed because the account is not
+// entitled to the requested data, e.g. premium contacts. The status code is
+// not enough, authentication and permission errors are a 403 as well.
+func (apiError *APIError) NotEntitled() bool {
+	message := strings.ToLower(apiError.Message + " " + apiError.Description)
+
+	return strings.Contains(message, "not entitled") || strings.Contains(message, "entitlement")
+}
+
+// newAPIError builds the error from the response, the body is decoded on a
+// best effort basis as not every failing response carries a D&B error.
+func (client *Client) newAPIError(endpoint string, statusCode int, body []byte) *APIError {
+	apiError := &APIError{
+		StatusCode: statusCode,
+		Endpoint:   endpoint,
+		Retryable:  client.retryPolicy.isRetryableStatus(statusCode),
+		Body:       body,
+	}
+
+	errorResponse := &api_response.ErrorResponse{}
+
+	err := json.Unmarshal(body, errorResponse)
+	if err != nil {
+		return apiError
+	}
+
+	apiError.ErrorCode = errorResponse.ErrorCode
+	apiError.Message = errorResponse.ErrorMessage
+	apiError.Description = errorResponse.ErrorDescription
+	apiError.TransactionID = errorResponse.TransactionDetail.TransactionID
+
+	if apiError.Description == "" {
+		apiError.Description = errorResponse.Error
+	}
+
+	return apiError
+}
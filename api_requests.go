@@ -1,67 +1,94 @@
 package dnbclient
 
+import (
+	"net/url"
+	"strconv"
+)
+
 type RequestBody struct {
-	CompanySearch *CompanySearchRequest
-	ContactSearch *ContactSearchRequest
+	CompanySearch  *CompanySearchRequest
+	ContactSearch  *ContactSearchRequest
+	TypeheadSearch *TypeheadSearchRequest
+}
+
+// newRequestBody builds the request body of a single call from the request options.
+func newRequestBody(options ...RequestOptions) *RequestBody {
+	body := &RequestBody{
+		CompanySearch:  &CompanySearchRequest{},
+		ContactSearch:  &ContactSearchRequest{},
+		TypeheadSearch: &TypeheadSearchRequest{},
+	}
+
+	for _, option := range options {
+		option(body)
+	}
+
+	return body
 }
 
 // Company Search Request
 type CompanySearchRequest struct {
-	DUNS                    string   `json:"duns,omitempty"`
-	DUNSList                []string `json:"dunsList,omitempty"`
-	IsMarketable            bool     `json:"isMarketable,omitempty"`
-	IsOutOffBusiness        bool     `json:"isOutOffBusiness,omitempty"`
-	IsTelephoneDisconnected bool     `json:"isTelephoneDisconnected,omitempty"`
-	IsMailUndeliverable     bool     `json:"isMailUndeliverable,omitempty"`
-	SearchTerm              string   `json:"searchTerm,omitempty"`
-	PrimaryName             string   `json:"primaryName,omitempty"`
-	TradeStyleName          string   `json:"tradeStyleName,omitempty"`
-	CountryISOAlpha2Code    string   `json:"countryISOAlpha2Code,omitempty"`
-	AddressRegion           string   `json:"addressRegion,omitempty"`
-	AddressLocality         string   `json:"addressLocality,omitempty"`
-	StreetAddressLine1      string   `json:"streetAddressLine1,omitempty"`
-	PostalCode              string   `json:"postalCode,omitempty"`
-	TelephoneNumber         string   `json:"telephoneNumber,omitempty"`
-	Domain                  string   `json:"domain,omitempty"`
-	TickerSymbol            string   `json:"tickerSymbol,omitempty"`
-	IsStandalone            bool     `json:"isStandalone,omitempty"`
-	IsImporter              bool     `json:"isImporter,omitempty"`
-	IsExporter              bool     `json:"isExporter,omitempty"`
-	PageNumber              int      `json:"pageNumber,omitempty"`
-	PageSize                int      `json:"pageSize,omitempty"`
-	ReturnNavigators        bool     `json:"returnNavigators,omitempty"`
-	RegistrationNumbers     []string `json:"registrationNumbers,omitempty"`
-	BusinessEntityType      []string `json:"businessEntityType,omitempty"`
-	FamilytreeRolesPlayed   []string `json:"familytreeRolesPlayed,omitempty"`
-	UsSicv4                 []string `json:"usSicv4,omitempty"`
-	LocationRadius          *struct {
-		Lat    float64 `json:"lat,omitempty"`
-		Lng    float64 `json:"lon,omitempty"`
-		Radius float64 `json:"radius,omitempty"`
-		Unit   string  `json:"unit,omitempty"`
-	} `json:"locationRadius,omitempty"`
-
-	NumberOfEmployees *struct {
-		InformationScope int `json:"informationScope,omitempty"`
-		MaximumValue     int `json:"maximumValue,omitempty"`
-		MinimumValue     int `json:"minimumValue,omitempty"`
-	} `json:"numberOfEmployees,omitempty"`
-
-	YearlyRevenue *struct {
-		MaximumValue int `json:"maximumValue,omitempty"`
-		MinimumValue int `json:"minimumValue,omitempty"`
-	} `json:"yearlyRevenue,omitempty"`
-
-	GlobalUltimateramilyTreeMembersCount *struct {
-		MaximumValue int `json:"maximumValue,omitempty"`
-		MinimumValue int `json:"minimumValue,omitempty"`
-	} `json:"globalUltimateFamilyTreeMembersCount,omitempty"`
-
-	IndustryCodes []*struct {
-		TypeDnbCode string   `json:"typeDnbCode,omitempty"`
-		Description string   `json:"description,omitempty"`
-		Code        []string `json:"code,omitempty"`
-	} `json:"industryCodes,omitempty"`
+	DUNS                    DUNS                   `json:"duns,omitempty"`
+	DUNSList                []DUNS                 `json:"dunsList,omitempty"`
+	IsMarketable            bool                   `json:"isMarketable,omitempty"`
+	IsOutOffBusiness        bool                   `json:"isOutOffBusiness,omitempty"`
+	IsTelephoneDisconnected bool                   `json:"isTelephoneDisconnected,omitempty"`
+	IsMailUndeliverable     bool                   `json:"isMailUndeliverable,omitempty"`
+	SearchTerm              string                 `json:"searchTerm,omitempty"`
+	PrimaryName             string                 `json:"primaryName,omitempty"`
+	TradeStyleName          string                 `json:"tradeStyleName,omitempty"`
+	CountryISOAlpha2Code    string                 `json:"countryISOAlpha2Code,omitempty"`
+	AddressRegion           string                 `json:"addressRegion,omitempty"`
+	AddressLocality         string                 `json:"addressLocality,omitempty"`
+	StreetAddressLine1      string                 `json:"streetAddressLine1,omitempty"`
+	PostalCode              string                 `json:"postalCode,omitempty"`
+	TelephoneNumber         string                 `json:"telephoneNumber,omitempty"`
+	Domain                  string                 `json:"domain,omitempty"`
+	TickerSymbol            string                 `json:"tickerSymbol,omitempty"`
+	IsStandalone            bool                   `json:"isStandalone,omitempty"`
+	IsImporter              bool                   `json:"isImporter,omitempty"`
+	IsExporter              bool                   `json:"isExporter,omitempty"`
+	PageNumber              int                    `json:"pageNumber,omitempty"`
+	PageSize                int                    `json:"pageSize,omitempty"`
+	ReturnNavigators        bool                   `json:"returnNavigators,omitempty"`
+	RegistrationNumbers     []string               `json:"registrationNumbers,omitempty"`
+	BusinessEntityType      []string               `json:"businessEntityType,omitempty"`
+	FamilytreeRolesPlayed   []string               `json:"familytreeRolesPlayed,omitempty"`
+	UsSicv4                 []string               `json:"usSicv4,omitempty"`
+	LocationRadius          *LocationRadius        `json:"locationRadius,omitempty"`
+	NumberOfEmployees       *EmployeeRange         `json:"numberOfEmployees,omitempty"`
+	YearlyRevenue           *ValueRange            `json:"yearlyRevenue,omitempty"`
+	IndustryCodes           []*CompanyIndustryCode `json:"industryCodes,omitempty"`
+
+	GlobalUltimateramilyTreeMembersCount *ValueRange `json:"globalUltimateFamilyTreeMembersCount,omitempty"`
+}
+
+// Search radius around a point
+type LocationRadius struct {
+	Lat    float64 `json:"lat,omitempty"`
+	Lng    float64 `json:"lon,omitempty"`
+	Radius float64 `json:"radius,omitempty"`
+	Unit   string  `json:"unit,omitempty"`
+}
+
+// Range of the number of employees
+type EmployeeRange struct {
+	InformationScope int `json:"informationScope,omitempty"`
+	MaximumValue     int `json:"maximumValue,omitempty"`
+	MinimumValue     int `json:"minimumValue,omitempty"`
+}
+
+// Range of a numeric search filter
+type ValueRange struct {
+	MaximumValue int `json:"maximumValue,omitempty"`
+	MinimumValue int `json:"minimumValue,omitempty"`
+}
+
+// Industry code filter of a company search
+type CompanyIndustryCode struct {
+	TypeDnbCode string   `json:"typeDnbCode,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Code        []string `json:"code,omitempty"`
 }
 
 // Typehead Search Request
@@ -85,6 +112,53 @@ type TypeheadSearchRequest struct {
 	CustomerReference        string  `json:"customerReference,omitempty"`
 }
 
+// queryParams encodes the populated fields as query parameters, the
+// typeahead endpoint does not accept a request body.
+func (request *TypeheadSearchRequest) queryParams() url.Values {
+	params := url.Values{}
+
+	addString := func(key string, value string) {
+		if value != "" {
+			params.Add(key, value)
+		}
+	}
+
+	addFloat := func(key string, value float64) {
+		if value != 0 {
+			params.Add(key, strconv.FormatFloat(value, 'f', -1, 64))
+		}
+	}
+
+	addBool := func(key string, value bool) {
+		if value {
+			params.Add(key, "true")
+		}
+	}
+
+	addString("searchTerm", request.SearchTerm)
+	addString("countryISOAlpha2Code", request.CountryISOAlpha2Code)
+	addBool("isOutOfBusiness", request.IsOutOfBusiness)
+	addBool("isMarketable", request.IsMarketable)
+	addBool("isDelisted", request.IsDelisted)
+	addBool("isMailUndeliverable", request.IsMailUndeliverable)
+	addString("addressLocality", request.AddressLocality)
+	addString("addressRegion", request.AddressRegion)
+	addString("streetAddressLine1", request.StreetAddressLine1)
+	addString("postalCode", request.PostalCode)
+	addFloat("radiusLat", request.RadiusLat)
+	addFloat("radiusLon", request.RadiusLon)
+	addString("radiusPostalCode", request.RadiusPostalCode)
+	addFloat("radiusDistance", request.RadiusDistance)
+	addString("radiusUnit", request.RadiusUnit)
+	addString("customerReference", request.CustomerReference)
+
+	if request.CandidateMaximumQuantity > 0 {
+		params.Add("candidateMaximumQuantity", strconv.Itoa(request.CandidateMaximumQuantity))
+	}
+
+	return params
+}
+
 // Contact Search Request
 type ContactSearchRequest struct {
 	ContactID            string          `json:"contactID,omitempty"`
@@ -92,7 +166,7 @@ type ContactSearchRequest struct {
 	GivenName            string          `json:"givenName,omitempty"`
 	FamilyName           string          `json:"familyName,omitempty"`
 	JobTitles            []string        `json:"jobTitles,omitempty"`
-	Duns                 string          `json:"duns,omitempty"`
+	Duns                 DUNS            `json:"duns,omitempty"`
 	PrimaryName          string          `json:"primaryName,omitempty"`
 	AddressLocality      string          `json:"addressLocality,omitempty"`
 	AddressRegion        string          `json:"addressRegion,omitempty"`
@@ -1,5 +1,7 @@
 package api_response
 
+import "encoding/json"
+
 type Base struct {
 	TransactionDetail          TransactionDetail `json:"transactionDetail,omitempty"`
 	CandidatesMatchedQuantity  int               `json:"candidatesMatchedQuantity,omitempty"`
@@ -14,8 +16,56 @@ type TransactionDetail struct {
 }
 
 type ErrorResponse struct {
-	Error            string `json:"error,omitempty"`
-	ErrorDescription string `json:"error_description,omitempty"`
-	ErrorCode        string `json:"errorCode,omitempty"`
-	ErrorMessage     string `json:"errorMessage,omitempty"`
+	TransactionDetail TransactionDetail `json:"transactionDetail,omitempty"`
+	Error             string            `json:"error,omitempty"`
+	ErrorDescription  string            `json:"error_description,omitempty"`
+	ErrorCode         string            `json:"errorCode,omitempty"`
+	ErrorMessage      string            `json:"errorMessage,omitempty"`
+}
+
+// UnmarshalJSON decodes both the flat authentication errors, where error is
+// a string, and the Direct+ errors which nest the code and message in an
+// error object.
+func (response *ErrorResponse) UnmarshalJSON(data []byte) error {
+	type errorResponse ErrorResponse
+
+	var raw struct {
+		errorResponse
+		Error json.RawMessage `json:"error,omitempty"`
+	}
+
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	*response = ErrorResponse(raw.errorResponse)
+
+	if len(raw.Error) == 0 {
+		return nil
+	}
+
+	if raw.Error[0] == '"' {
+		return json.Unmarshal(raw.Error, &response.Error)
+	}
+
+	var detail struct {
+		ErrorCode    string `json:"errorCode,omitempty"`
+		ErrorMessage string `json:"errorMessage,omitempty"`
+	}
+
+	err = json.Unmarshal(raw.Error, &detail)
+	if err != nil {
+		return err
+	}
+
+	if response.ErrorCode == "" {
+		response.ErrorCode = detail.ErrorCode
+	}
+
+	if response.ErrorMessage == "" {
+		response.ErrorMessage = detail.ErrorMessage
+	}
+
+	return nil
 }
@@ -0,0 +1,196 @@
+package api_response
+
+import "sort"
+
+// Beneficiary types, DnbCode.DnbCode of BeneficialOwner.BeneficiaryType
+const (
+	BeneficiaryTypeBusiness       = 118
+	BeneficiaryTypeIndividual     = 119
+	BeneficiaryTypeGovernmentBody = 120
+	BeneficiaryTypeUnclassified   = 121
+)
+
+type BeneficialOwnership struct {
+	TransactionDetail TransactionDetail          `json:"transactionDetail,omitempty"`
+	InquiryDetail     BeneficialOwnershipInquiry `json:"inquiryDetail,omitempty"`
+	Organization      BeneficialOwnershipSubject `json:"organization,omitempty"`
+}
+
+type BeneficialOwnershipInquiry struct {
+	Duns                DUNS    `json:"duns,omitempty"`
+	ProductID           string  `json:"productID,omitempty"`
+	ProductVersion      string  `json:"productVersion,omitempty"`
+	OwnershipPercentage float64 `json:"ownershipPercentage,omitempty"`
+}
+
+type BeneficialOwnershipSubject struct {
+	Duns                 DUNS   `json:"duns,omitempty"`
+	PrimaryName          string `json:"primaryName,omitempty"`
+	CountryISOAlpha2Code string `json:"countryISOAlpha2Code,omitempty"`
+
+	BeneficialOwnershipSummary struct {
+		BeneficialOwnersCount             int     `json:"beneficialOwnersCount,omitempty"`
+		PersonsCount                      int     `json:"personsCount,omitempty"`
+		OrganizationsCount                int     `json:"organizationsCount,omitempty"`
+		MaximumDegreeOfSeparation         int     `json:"maximumDegreeOfSeparation,omitempty"`
+		TotalAllocatedOwnershipPercentage float64 `json:"totalAllocatedOwnershipPercentage,omitempty"`
+	} `json:"beneficialOwnershipSummary,omitempty"`
+
+	BeneficialOwnership OwnershipGraph `json:"beneficialOwnership,omitempty"`
+}
+
+// OwnershipGraph holds the owners of the organization and the ownership
+// relationships between them.
+type OwnershipGraph struct {
+	BeneficialOwners []BeneficialOwner       `json:"beneficialOwners,omitempty"`
+	Relationships    []OwnershipRelationship `json:"relationships,omitempty"`
+}
+
+type BeneficialOwner struct {
+	MemberID        int          `json:"memberID,omitempty"`
+	Duns            DUNS         `json:"duns,omitempty"`
+	Name            string       `json:"name,omitempty"`
+	BeneficiaryType DnbCode      `json:"beneficiaryType,omitempty"`
+	IsBeneficiary   bool         `json:"isBeneficiary,omitempty"`
+	Address         BlockAddress `json:"address,omitempty"`
+
+	Nationality struct {
+		Name          string `json:"name,omitempty"`
+		IsoAlpha2Code string `json:"isoAlpha2Code,omitempty"`
+	} `json:"nationality,omitempty"`
+
+	// Number of ownership relationships between the owner and the organization
+	DegreeOfSeparation int `json:"degreeOfSeparation,omitempty"`
+
+	DirectOwnershipPercentage     float64 `json:"directOwnershipPercentage,omitempty"`
+	IndirectOwnershipPercentage   float64 `json:"indirectOwnershipPercentage,omitempty"`
+	BeneficialOwnershipPercentage float64 `json:"beneficialOwnershipPercentage,omitempty"`
+}
+
+// OwnershipRelationship is an edge of the ownership graph, the source member
+// owns the share percentage of the target member.
+type OwnershipRelationship struct {
+	SourceMemberID  int     `json:"sourceMemberID,omitempty"`
+	TargetMemberID  int     `json:"targetMemberID,omitempty"`
+	SharePercentage float64 `json:"sharePercentage,omitempty"`
+
+	RelationshipType DnbCode `json:"relationshipType,omitempty"`
+}
+
+// IsPerson reports whether the owner is an individual.
+func (owner *BeneficialOwner) IsPerson() bool {
+	return owner.BeneficiaryType.DnbCode == BeneficiaryTypeIndividual
+}
+
+// IsOrganization reports whether the owner is a business or another
+// non-individual entity.
+func (owner *BeneficialOwner) IsOrganization() bool {
+	return !owner.IsPerson()
+}
+
+// Country returns the country code of the owner, the nationality of persons
+// and the address country of organizations, whichever is known.
+func (owner *BeneficialOwner) Country() string {
+	if owner.IsPerson() && owner.Nationality.IsoAlpha2Code != "" {
+		return owner.Nationality.IsoAlpha2Code
+	}
+
+	return owner.Address.AddressCountry.IsoAlpha2Code
+}
+
+// Percentage returns the total direct and indirect ownership of the owner.
+func (owner *BeneficialOwner) Percentage() float64 {
+	if owner.BeneficialOwnershipPercentage > 0 {
+		return owner.BeneficialOwnershipPercentage
+	}
+
+	return owner.DirectOwnershipPercentage + owner.IndirectOwnershipPercentage
+}
+
+// Flatten returns the ultimate beneficial owners holding at least the
+// threshold percentage of the organization, highest percentage first.
+// Intermediate owners, e.g. holding companies owned by others, are left out
+// as their shares are already counted for the owners behind them. An owner
+// is ultimate when it is flagged as beneficiary or nobody in the graph owns
+// it. Percentages missing from the response are derived from the
+// relationships, multiplying the shares along every path from the owner to
+// the organization.
+func (graph *OwnershipGraph) Flatten(threshold float64) []BeneficialOwner {
+	derived := graph.derivedPercentages()
+	owners := []BeneficialOwner{}
+
+	targets := map[int]bool{}
+	for _, relationship := range graph.Relationships {
+		targets[relationship.TargetMemberID] = true
+	}
+
+	for _, owner := range graph.BeneficialOwners {
+		if !owner.IsBeneficiary && targets[owner.MemberID] {
+			continue
+		}
+
+		if owner.Percentage() == 0 {
+			owner.BeneficialOwnershipPercentage = derived[owner.MemberID]
+		}
+
+		if owner.Percentage() > 0 && owner.Percentage() >= threshold {
+			owners = append(owners, owner)
+		}
+	}
+
+	sort.SliceStable(owners, func(i, j int) bool {
+		return owners[i].Percentage() > owners[j].Percentage()
+	})
+
+	return owners
+}
+
+// derivedPercentages returns the ownership of every member in the
+// organization, the members that do not own anyone are the organization.
+func (graph *OwnershipGraph) derivedPercentages() map[int]float64 {
+	owned := map[int][]OwnershipRelationship{}
+	sources := map[int]bool{}
+
+	for _, relationship := range graph.Relationships {
+		owned[relationship.SourceMemberID] = append(owned[relationship.SourceMemberID], relationship)
+		sources[relationship.SourceMemberID] = true
+	}
+
+	percentages := map[int]float64{}
+	visiting := map[int]bool{}
+
+	var share func(memberID int) float64
+
+	share = func(memberID int) float64 {
+		if !sources[memberID] {
+			return 1
+		}
+
+		if percentage, ok := percentages[memberID]; ok {
+			return percentage / 100
+		}
+
+		// Circular ownership adds nothing past the first cycle.
+		if visiting[memberID] {
+			return 0
+		}
+
+		visiting[memberID] = true
+		total := 0.0
+
+		for _, relationship := range owned[memberID] {
+			total += relationship.SharePercentage / 100 * share(relationship.TargetMemberID)
+		}
+
+		visiting[memberID] = false
+		percentages[memberID] = total * 100
+
+		return total
+	}
+
+	for memberID := range sources {
+		share(memberID)
+	}
+
+	return percentages
+}
@@ -71,7 +71,7 @@ type CompanyInquiryDetail struct {
 }
 
 type Organization struct {
-	Duns         string `json:"duns"`
+	Duns         DUNS   `json:"duns"`
 	IsStandalone bool   `json:"isStandalone"`
 	PrimaryName  string `json:"primaryName"`
 
@@ -89,8 +89,8 @@ type Organization struct {
 	} `json:"primaryAddress"`
 
 	CorporateLinkage struct {
-		GlobalUltimateDuns string `json:"globalUltimateDuns"`
-		ParentDuns         string `json:"parentDuns"`
+		GlobalUltimateDuns DUNS `json:"globalUltimateDuns"`
+		ParentDuns         DUNS `json:"parentDuns"`
 	} `json:"corporateLinkage"`
 
 	BusinessEntityType struct {
@@ -0,0 +1,273 @@
+package api_response
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+type DataBlocks struct {
+	TransactionDetail TransactionDetail      `json:"transactionDetail,omitempty"`
+	InquiryDetail     DataBlocksInquiry      `json:"inquiryDetail,omitempty"`
+	BlockStatus       []DataBlockStatus      `json:"blockStatus,omitempty"`
+	Organization      DataBlocksOrganization `json:"organization,omitempty"`
+}
+
+type DataBlocksInquiry struct {
+	Duns              DUNS     `json:"duns,omitempty"`
+	BlockIDs          []string `json:"blockIDs,omitempty"`
+	TradeUp           string   `json:"tradeUp,omitempty"`
+	CustomerReference string   `json:"customerReference,omitempty"`
+}
+
+type DataBlockStatus struct {
+	BlockID string `json:"blockID,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// DataBlocksOrganization holds the attributes of all requested blocks, the
+// blocks are merged into a single organization object by the API.
+type DataBlocksOrganization struct {
+	// companyinfo
+	CompanyInfo
+
+	// principalscontacts
+	PrincipalsContacts
+
+	// hierarchyconnections
+	CorporateLinkage *HierarchyConnections `json:"corporateLinkage,omitempty"`
+
+	// financialstrengthinsight
+	DnbAssessment *FinancialStrengthInsight `json:"dnbAssessment,omitempty"`
+
+	// Attributes of blocks without a typed model, e.g. blocks added to the
+	// API later, keyed by attribute name
+	Unknown map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the typed blocks and keeps the remaining attributes
+// as raw JSON in Unknown.
+func (organization *DataBlocksOrganization) UnmarshalJSON(data []byte) error {
+	type dataBlocksOrganization DataBlocksOrganization
+
+	err := json.Unmarshal(data, (*dataBlocksOrganization)(organization))
+	if err != nil {
+		return err
+	}
+
+	attributes := map[string]json.RawMessage{}
+
+	err = json.Unmarshal(data, &attributes)
+	if err != nil {
+		return err
+	}
+
+	organization.Unknown = nil
+
+	for name, value := range attributes {
+		if dataBlockAttributes[name] {
+			continue
+		}
+
+		if organization.Unknown == nil {
+			organization.Unknown = map[string]json.RawMessage{}
+		}
+
+		organization.Unknown[name] = value
+	}
+
+	return nil
+}
+
+// dataBlockAttributes holds the attribute names of the typed blocks.
+var dataBlockAttributes = jsonNames(reflect.TypeOf(DataBlocksOrganization{}), map[string]bool{})
+
+func jsonNames(structType reflect.Type, names map[string]bool) map[string]bool {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		if field.Anonymous {
+			jsonNames(field.Type, names)
+
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names[name] = true
+		}
+	}
+
+	return names
+}
+
+type DnbCode struct {
+	Description string `json:"description,omitempty"`
+	DnbCode     int    `json:"dnbCode,omitempty"`
+}
+
+type BlockAddress struct {
+	AddressCountry struct {
+		Name          string `json:"name,omitempty"`
+		IsoAlpha2Code string `json:"isoAlpha2Code,omitempty"`
+	} `json:"addressCountry,omitempty"`
+
+	AddressLocality struct {
+		Name string `json:"name,omitempty"`
+	} `json:"addressLocality,omitempty"`
+
+	AddressRegion struct {
+		Name               string `json:"name,omitempty"`
+		AbbreviatedName    string `json:"abbreviatedName,omitempty"`
+		IsoSubDivisionCode string `json:"isoSubDivisionCode,omitempty"`
+	} `json:"addressRegion,omitempty"`
+
+	PostalCode string `json:"postalCode,omitempty"`
+
+	StreetAddress struct {
+		Line1 string `json:"line1,omitempty"`
+		Line2 string `json:"line2,omitempty"`
+	} `json:"streetAddress,omitempty"`
+}
+
+// companyinfo block, level 1 and 2 attributes
+type CompanyInfo struct {
+	Duns                 DUNS         `json:"duns,omitempty"`
+	PrimaryName          string       `json:"primaryName,omitempty"`
+	RegisteredName       string       `json:"registeredName,omitempty"`
+	CountryISOAlpha2Code string       `json:"countryISOAlpha2Code,omitempty"`
+	PrimaryAddress       BlockAddress `json:"primaryAddress,omitempty"`
+	MailingAddress       BlockAddress `json:"mailingAddress,omitempty"`
+	RegisteredAddress    BlockAddress `json:"registeredAddress,omitempty"`
+	StartDate            string       `json:"startDate,omitempty"`
+	IncorporatedDate     string       `json:"incorporatedDate,omitempty"`
+	BusinessEntityType   DnbCode      `json:"businessEntityType,omitempty"`
+	LegalForm            DnbCode      `json:"legalForm,omitempty"`
+	ControlOwnershipType DnbCode      `json:"controlOwnershipType,omitempty"`
+	IsStandalone         bool         `json:"isStandalone,omitempty"`
+
+	DunsControlStatus struct {
+		OperatingStatus         DnbCode `json:"operatingStatus,omitempty"`
+		IsMarketable            bool    `json:"isMarketable,omitempty"`
+		IsMailUndeliverable     bool    `json:"isMailUndeliverable,omitempty"`
+		IsTelephoneDisconnected bool    `json:"isTelephoneDisconnected,omitempty"`
+		IsDelisted              bool    `json:"isDelisted,omitempty"`
+	} `json:"dunsControlStatus,omitempty"`
+
+	TradeStyleNames []struct {
+		Name     string `json:"name,omitempty"`
+		Priority int    `json:"priority,omitempty"`
+	} `json:"tradeStyleNames,omitempty"`
+
+	WebsiteAddress []struct {
+		URL        string `json:"url,omitempty"`
+		DomainName string `json:"domainName,omitempty"`
+	} `json:"websiteAddress,omitempty"`
+
+	Telephone []struct {
+		TelephoneNumber string `json:"telephoneNumber,omitempty"`
+		IsdCode         string `json:"isdCode,omitempty"`
+	} `json:"telephone,omitempty"`
+
+	RegistrationNumbers []struct {
+		RegistrationNumber string `json:"registrationNumber,omitempty"`
+		TypeDescription    string `json:"typeDescription,omitempty"`
+		TypeDnbCode        int    `json:"typeDnbCode,omitempty"`
+	} `json:"registrationNumbers,omitempty"`
+
+	PrimaryIndustryCode struct {
+		UsSicV4            string `json:"usSicV4,omitempty"`
+		UsSicV4Description string `json:"usSicV4Description,omitempty"`
+	} `json:"primaryIndustryCode,omitempty"`
+
+	IndustryCodes []struct {
+		Code            string `json:"code,omitempty"`
+		Description     string `json:"description,omitempty"`
+		TypeDescription string `json:"typeDescription,omitempty"`
+		TypeDnbCode     int    `json:"typeDnbCode,omitempty"`
+		Priority        int    `json:"priority,omitempty"`
+	} `json:"industryCodes,omitempty"`
+
+	NumberOfEmployees []struct {
+		Value                       int    `json:"value,omitempty"`
+		InformationScopeDescription string `json:"informationScopeDescription,omitempty"`
+		InformationScopeDnbCode     int    `json:"informationScopeDnbCode,omitempty"`
+		ReliabilityDescription      string `json:"reliabilityDescription,omitempty"`
+		ReliabilityDnbCode          int    `json:"reliabilityDnbCode,omitempty"`
+	} `json:"numberOfEmployees,omitempty"`
+
+	Financials []struct {
+		FinancialStatementToDate string `json:"financialStatementToDate,omitempty"`
+		ReliabilityDescription   string `json:"reliabilityDescription,omitempty"`
+
+		YearlyRevenue []struct {
+			Value    float64 `json:"value,omitempty"`
+			Currency string  `json:"currency,omitempty"`
+		} `json:"yearlyRevenue,omitempty"`
+	} `json:"financials,omitempty"`
+}
+
+type Principal struct {
+	FullName   string `json:"fullName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+
+	JobTitles []struct {
+		Title string `json:"title,omitempty"`
+	} `json:"jobTitles,omitempty"`
+
+	ManagementResponsibilities []struct {
+		Description string `json:"description,omitempty"`
+		MrcCode     string `json:"mrcCode,omitempty"`
+	} `json:"managementResponsibilities,omitempty"`
+}
+
+// principalscontacts block, the most senior principals from level 1 and the
+// current principals from level 2
+type PrincipalsContacts struct {
+	MostSeniorPrincipals []Principal `json:"mostSeniorPrincipals,omitempty"`
+	CurrentPrincipals    []Principal `json:"currentPrincipals,omitempty"`
+}
+
+type LinkedOrganization struct {
+	Duns           DUNS         `json:"duns,omitempty"`
+	PrimaryName    string       `json:"primaryName,omitempty"`
+	PrimaryAddress BlockAddress `json:"primaryAddress,omitempty"`
+}
+
+// hierarchyconnections block
+type HierarchyConnections struct {
+	FamilytreeRolesPlayed                []DnbCode           `json:"familytreeRolesPlayed,omitempty"`
+	HierarchyLevel                       int                 `json:"hierarchyLevel,omitempty"`
+	GlobalUltimateFamilyTreeMembersCount int                 `json:"globalUltimateFamilyTreeMembersCount,omitempty"`
+	GlobalUltimate                       *LinkedOrganization `json:"globalUltimate,omitempty"`
+	DomesticUltimate                     *LinkedOrganization `json:"domesticUltimate,omitempty"`
+	Parent                               *LinkedOrganization `json:"parent,omitempty"`
+	HeadQuarter                          *LinkedOrganization `json:"headQuarter,omitempty"`
+}
+
+type AssessmentScore struct {
+	ClassScore            int     `json:"classScore,omitempty"`
+	ClassScoreDescription string  `json:"classScoreDescription,omitempty"`
+	RawScore              int     `json:"rawScore,omitempty"`
+	NationalPercentile    int     `json:"nationalPercentile,omitempty"`
+	ScoreDate             string  `json:"scoreDate,omitempty"`
+	ScoreModel            DnbCode `json:"scoreModel,omitempty"`
+}
+
+// financialstrengthinsight block, level 1 and 2 attributes
+type FinancialStrengthInsight struct {
+	FailureScore            *AssessmentScore `json:"failureScore,omitempty"`
+	DelinquencyScore        *AssessmentScore `json:"delinquencyScore,omitempty"`
+	FinancialCondition      DnbCode          `json:"financialCondition,omitempty"`
+	HistoryRating           DnbCode          `json:"historyRating,omitempty"`
+	HasSevereNegativeEvents bool             `json:"hasSevereNegativeEvents,omitempty"`
+
+	StandardRating struct {
+		Rating            string `json:"rating,omitempty"`
+		FinancialStrength string `json:"financialStrength,omitempty"`
+		RiskSegment       string `json:"riskSegment,omitempty"`
+		ScoreDate         string `json:"scoreDate,omitempty"`
+	} `json:"standardRating,omitempty"`
+}
@@ -0,0 +1,96 @@
+package api_response
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrInvalidDUNS = errors.New("invalid D-U-N-S number")
+
+// DUNS is a 9 digit D-U-N-S number.
+type DUNS string
+
+// ParseDUNS normalizes a D-U-N-S number. Spaces, dashes and dots are
+// stripped and numbers that lost their leading zeros are padded to 9 digits,
+// so 15-048-3782 and 60704780 become 150483782 and 060704780.
+func ParseDUNS(value string) (DUNS, error) {
+	digits := strings.Map(func(char rune) rune {
+		switch char {
+		case ' ', '-', '.':
+			return -1
+		}
+
+		return char
+	}, value)
+
+	if digits == "" || len(digits) > 9 {
+		return "", fmt.Errorf("%w, %q", ErrInvalidDUNS, value)
+	}
+
+	for _, char := range digits {
+		if char < '0' || char > '9' {
+			return "", fmt.Errorf("%w, %q", ErrInvalidDUNS, value)
+		}
+	}
+
+	return DUNS(strings.Repeat("0", 9-len(digits)) + digits), nil
+}
+
+// Validate returns an error when the number can not be normalized to 9 digits.
+func (duns DUNS) Validate() error {
+	_, err := ParseDUNS(string(duns))
+
+	return err
+}
+
+// Normalize returns the normalized number, or the number unchanged when it is invalid.
+func (duns DUNS) Normalize() DUNS {
+	normalized, err := ParseDUNS(string(duns))
+	if err != nil {
+		return duns
+	}
+
+	return normalized
+}
+
+func (duns DUNS) String() string {
+	return string(duns)
+}
+
+// Format returns the number in the dashed 15-048-3782 notation.
+func (duns DUNS) Format() string {
+	normalized, err := ParseDUNS(string(duns))
+	if err != nil {
+		return string(duns)
+	}
+
+	return string(normalized[:2]) + "-" + string(normalized[2:5]) + "-" + string(normalized[5:])
+}
+
+// MarshalJSON encodes the normalized number.
+func (duns DUNS) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(duns.Normalize()))
+}
+
+// UnmarshalJSON decodes the number from a string or a JSON number and
+// normalizes it. Values that are not D-U-N-S numbers are kept as they are.
+func (duns *DUNS) UnmarshalJSON(data []byte) error {
+	var value string
+
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		var number json.Number
+
+		if json.Unmarshal(data, &number) != nil {
+			return err
+		}
+
+		value = number.String()
+	}
+
+	*duns = DUNS(value).Normalize()
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package api_response
+
+type FamilyTree struct {
+	TransactionDetail TransactionDetail  `json:"transactionDetail,omitempty"`
+	InquiryDetail     FamilyTreeInquiry  `json:"inquiryDetail,omitempty"`
+	Links             FamilyTreeLinks    `json:"links,omitempty"`
+	FamilyTreeMembers []FamilyTreeMember `json:"familyTreeMembers,omitempty"`
+
+	GlobalUltimateDuns                   DUNS `json:"globalUltimateDuns,omitempty"`
+	GlobalUltimateFamilyTreeMembersCount int  `json:"globalUltimateFamilyTreeMembersCount,omitempty"`
+	BranchesExcludedMembersCount         int  `json:"branchesExcludedMembersCount,omitempty"`
+}
+
+type FamilyTreeLinks struct {
+	Self string `json:"self,omitempty"`
+	Next string `json:"next,omitempty"`
+}
+
+type FamilyTreeInquiry struct {
+	Duns       DUNS `json:"duns,omitempty"`
+	PageNumber int  `json:"pageNumber,omitempty"`
+	PageSize   int  `json:"pageSize,omitempty"`
+}
+
+type FamilyTreeMember struct {
+	Duns                 DUNS                 `json:"duns,omitempty"`
+	PrimaryName          string               `json:"primaryName,omitempty"`
+	PrimaryAddress       BlockAddress         `json:"primaryAddress,omitempty"`
+	CountryISOAlpha2Code string               `json:"countryISOAlpha2Code,omitempty"`
+	CorporateLinkage     HierarchyConnections `json:"corporateLinkage,omitempty"`
+	DunsControlStatus    struct {
+		OperatingStatus DnbCode `json:"operatingStatus,omitempty"`
+	} `json:"dunsControlStatus,omitempty"`
+}
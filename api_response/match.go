@@ -0,0 +1,84 @@
+package api_response
+
+type Match struct {
+	Base
+	InquiryDetail   MatchInquiryDetail `json:"inquiryDetail,omitempty"`
+	MatchCandidates []MatchCandidate   `json:"matchCandidates,omitempty"`
+
+	MatchDataCriteria string `json:"matchDataCriteria,omitempty"`
+}
+
+type MatchInquiryDetail struct {
+	Duns                 DUNS   `json:"duns,omitempty"`
+	Name                 string `json:"name,omitempty"`
+	TelephoneNumber      string `json:"telephoneNumber,omitempty"`
+	CountryISOAlpha2Code string `json:"countryISOAlpha2Code,omitempty"`
+
+	Address struct {
+		CountryISOAlpha2Code string   `json:"countryISOAlpha2Code,omitempty"`
+		AddressLocality      string   `json:"addressLocality,omitempty"`
+		AddressRegion        string   `json:"addressRegion,omitempty"`
+		PostalCode           string   `json:"postalCode,omitempty"`
+		StreetAddressLine1   []string `json:"streetAddressLine1,omitempty"`
+	} `json:"address,omitempty"`
+}
+
+type MatchCandidate struct {
+	DisplaySequence         int                     `json:"displaySequence,omitempty"`
+	Organization            Organization            `json:"organization,omitempty"`
+	MatchQualityInformation MatchQualityInformation `json:"matchQualityInformation,omitempty"`
+}
+
+type MatchQualityInformation struct {
+	// 1 to 10, 10 being an exact match
+	ConfidenceCode int `json:"confidenceCode,omitempty"`
+
+	// Match grade per component, e.g. AAZZAZZZFAZ
+	MatchGrade           string                `json:"matchGrade,omitempty"`
+	MatchGradeComponents []MatchGradeComponent `json:"matchGradeComponents,omitempty"`
+
+	// Match data profile, e.g. 0000000000989800000000009898
+	MatchDataProfile           string                      `json:"matchDataProfile,omitempty"`
+	MatchDataProfileComponents []MatchDataProfileComponent `json:"matchDataProfileComponents,omitempty"`
+
+	NameMatchScore float64 `json:"nameMatchScore,omitempty"`
+}
+
+type MatchGradeComponent struct {
+	ComponentType   string  `json:"componentType,omitempty"`
+	ComponentRating string  `json:"componentRating,omitempty"`
+	ComponentScore  float64 `json:"componentScore,omitempty"`
+}
+
+type MatchDataProfileComponent struct {
+	ComponentType  string `json:"componentType,omitempty"`
+	ComponentValue string `json:"componentValue,omitempty"`
+}
+
+// Accept returns the best candidate when its confidence code is at or above
+// the threshold, candidates are ranked by the API.
+func (match *Match) Accept(threshold int) (*MatchCandidate, bool) {
+	if len(match.MatchCandidates) == 0 {
+		return nil, false
+	}
+
+	candidate := &match.MatchCandidates[0]
+	if candidate.MatchQualityInformation.ConfidenceCode < threshold {
+		return nil, false
+	}
+
+	return candidate, true
+}
+
+// CandidatesAbove returns the candidates with a confidence code at or above the threshold.
+func (match *Match) CandidatesAbove(threshold int) []MatchCandidate {
+	candidates := []MatchCandidate{}
+
+	for _, candidate := range match.MatchCandidates {
+		if candidate.MatchQualityInformation.ConfidenceCode >= threshold {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return candidates
+}
@@ -0,0 +1,82 @@
+package dnbclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/struki84/dnbclient/api_response"
+)
+
+// Product of the beneficial ownership endpoint with the full ownership graph
+const (
+	BeneficialOwnershipProductID      = "cmpbol"
+	BeneficialOwnershipProductVersion = "v1"
+)
+
+// GetBeneficialOwnership returns the beneficial owners of the organization,
+// persons and organizations owning it directly or through other organizations.
+// OwnershipGraph.Flatten lists the ultimate owners above a percentage.
+//
+// # Parameters
+//
+// - ctx
+//
+// - duns: D-U-N-S number of the organization
+//
+// - ownershipPercentage: minimum ownership of the owners the API returns, 0 to 100, the API default when zero
+//
+// # Returns
+//
+// - BeneficialOwnership: ownership graph of the organization
+//
+// - error: error if any
+//
+// # Documentation
+//
+// - https://directplus.documentation.dnb.com/openAPI.html?apiID=cmpbol
+func (client *Client) GetBeneficialOwnership(ctx context.Context, duns DUNS, ownershipPercentage float64) (*api_response.BeneficialOwnership, error) {
+	ownership := &api_response.BeneficialOwnership{}
+	duns = duns.Normalize()
+
+	validator := &validator{}
+	validator.duns("duns", duns)
+
+	if duns == "" {
+		validator.add("duns", "is required")
+	}
+
+	if ownershipPercentage < 0 || ownershipPercentage > 100 {
+		validator.add("ownershipPercentage", "must be between 0 and 100")
+	}
+
+	err := client.validate(validator.err)
+	if err != nil {
+		return ownership, fmt.Errorf("%w, %w", ErrGetOwnershipFailed, err)
+	}
+
+	reqURL, err := url.Parse(client.BaseURL + BeneficialOwnershipURL)
+	if err != nil {
+		return ownership, fmt.Errorf("%w, %w", ErrGetOwnershipFailed, err)
+	}
+
+	params := reqURL.Query()
+	params.Add("duns", duns.String())
+	params.Add("productId", BeneficialOwnershipProductID)
+	params.Add("versionId", BeneficialOwnershipProductVersion)
+
+	if ownershipPercentage > 0 {
+		params.Add("ownershipPercentage", strconv.FormatFloat(ownershipPercentage, 'f', -1, 64))
+	}
+
+	reqURL.RawQuery = params.Encode()
+
+	err = client.do(ctx, http.MethodGet, reqURL.String(), nil, RequestInfo{DUNS: duns.String()}, ownership)
+	if err != nil {
+		return ownership, fmt.Errorf("%w, %w", ErrGetOwnershipFailed, err)
+	}
+
+	return ownership, nil
+}
@@ -1,16 +1,18 @@
 package dnbclient
 
 import (
-	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/struki84/dnbclient/api_response"
 )
@@ -31,14 +33,29 @@ const (
 	// Crteria compant search endpoint
 	CriteriaSearchURL = "/search/criteria"
 
-	// Typehead company search endpoint
-	TypeheadSearchURL = "/search/typehead"
+	// Typeahead company search endpoint
+	TypeheadSearchURL = "/search/typeahead"
 
 	// Company list search endpoint
 	CompanyListURL = "/search/companyList"
 
 	// Contact search endpoint
 	ContactSearchURL = "/search/contact"
+
+	// Data blocks endpoint, followed by the DUNS
+	DataBlocksURL = "/data/duns"
+
+	// Identity resolution cleanse match endpoint
+	CleanseMatchURL = "/match/cleanseMatch"
+
+	// Identity resolution extended match endpoint
+	ExtendedMatchURL = "/match/extendedMatch"
+
+	// Family tree endpoint, followed by the DUNS
+	FamilyTreeURL = "/familyTree"
+
+	// Beneficial ownership endpoint
+	BeneficialOwnershipURL = "/beneficialowner"
 )
 
 var (
@@ -51,6 +68,14 @@ var (
 	ErrGetContactsFailed    = errors.New("get contacts failed")
 	ErrNoSearchResults      = errors.New("no search results found")
 	ErrRequestFailed        = errors.New("http request failed with error")
+	ErrInvalidLink          = errors.New("link points to an unknown host")
+	ErrInvalidRequest       = errors.New("invalid request")
+	ErrNotEntitled          = errors.New("not entitled to the requested data")
+	ErrGetDataBlocksFailed  = errors.New("get data blocks failed")
+	ErrCleanseMatchFailed   = errors.New("cleanse match failed")
+	ErrExtendedMatchFailed  = errors.New("extended match failed")
+	ErrGetFamilyTreeFailed  = errors.New("get family tree failed")
+	ErrGetOwnershipFailed   = errors.New("get beneficial ownership failed")
 )
 
 type Client struct {
@@ -59,38 +84,70 @@ type Client struct {
 	ApiKey      string
 	ApiSecret   string
 	apiToken    string
-	options     []ClientOptions
 	BaseURL     string
-	RequestBody *RequestBody
+	TokenURL    string
+	tokens      *tokenSource
+	httpClient  *http.Client
+	transport   http.RoundTripper
+	retryPolicy RetryPolicy
+	limiter     *rateLimiter
+	logger      *slog.Logger
+	logLevels   LogLevels
+	hooks       []RequestHook
+	metrics     MetricsRecorder
+	middlewares []Middleware
+	pipeline    Handler
+
+	skipValidation bool
 }
 
 // NewClient creates a new DNB client
 //
 // Parameters
-// - options: allows ihntial configuration of the client
+// - options: client configuration like base url, credentials and transport,
+// applied once and shared by all requests
 //
 // Returns
 // - client: DNB client
 // - error: error if any
 func NewClient(options ...ClientOptions) (*Client, error) {
 	client := &Client{
-		BaseURL:     BaseURLV1,
-		RequestBody: &RequestBody{},
+		BaseURL:    BaseURLV1,
+		TokenURL:   BaseURLV3 + AuthURL,
+		tokens:     newTokenSource(),
+		httpClient: http.DefaultClient,
+		limiter:    newRateLimiter(),
+		logLevels:  DefaultLogLevels,
+	}
+
+	for _, option := range options {
+		option(client)
+	}
+
+	// The transport is applied once all options ran, so it is kept no
+	// matter whether WithHTTPClient comes before or after WithTransport.
+	if client.transport != nil {
+		httpClient := *client.httpClient
+		httpClient.Transport = client.transport
+
+		client.httpClient = &httpClient
 	}
 
+	client.pipeline = client.buildPipeline()
+
 	return client, nil
 }
 
 // GetToken generates B&B Direct+ API token, requires D&B api key and
-// api secret to generate token.
+// api secret to generate token. The token is cached together with its expiry
+// and reused by all search requests until it is close to expiring, after which
+// the client refreshes it automatically. Calling GetToken is not required when
+// api key and api secret are set on the client.
 //
 // # Parameters:
 //
 // - ctx
 //
-// - options: variatic parameter that allows passing in client options
-// like api key and api secret username and password in the function
-//
 // # Returns
 //
 // - token: API token string used in all consequent API requests
@@ -100,41 +157,46 @@ func NewClient(options ...ClientOptions) (*Client, error) {
 // # Documentation
 //
 // - https://directplus.documentation.dnb.com/openAPI.html?apiID=authenticationV3
-func (client *Client) GetToken(ctx context.Context, options ...ClientOptions) (string, error) {
-	client.loadOptions(options...)
+func (client *Client) GetToken(ctx context.Context) (string, error) {
+	token, expiresIn, err := client.requestToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	client.tokens.Set(token, expiresIn)
+
+	return token, nil
+}
+
+func (client *Client) requestToken(ctx context.Context) (string, time.Duration, error) {
+	// The token may be requested in the middle of another call, the details
+	// of that call do not belong to the token request.
+	ctx = withRequestInfo(ctx, RequestInfo{Endpoint: AuthURL})
 
 	credentials := client.ApiKey + ":" + client.ApiSecret
-	client.apiToken = base64.StdEncoding.EncodeToString([]byte(credentials))
+	basicToken := base64.StdEncoding.EncodeToString([]byte(credentials))
 
 	formData := url.Values{}
 	formData.Set("grant_type", "client_credentials")
 
 	reqBody := formData.Encode()
 
-	// var reqData struct {
-	// 	GrantType string `json:"grant_type"`
-	// }
-	//
-	// reqData.GrantType = "client_credentials"
-	//
-	// reqBytes, err := json.Marshal(reqData)
-	// if err != nil {
-	// 	return "", fmt.Errorf("%w, %w", ErrGetTokenFailed, err)
-	// }
-
-	reqURL := client.BaseURL + AuthURL
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, strings.NewReader(reqBody))
+	// Tokens are issued by the V3 API while the searches use V1, the token
+	// endpoint does not follow the base url.
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.TokenURL, strings.NewReader(reqBody))
 	if err != nil {
-		return "", fmt.Errorf("%w, %w", ErrGetTokenFailed, err)
+		return "", 0, fmt.Errorf("%w, %w", ErrGetTokenFailed, err)
 	}
 
-	req.Header.Add("Authorization", "Basic "+client.apiToken)
+	req.Header.Add("Authorization", "Basic "+basicToken)
 	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 	req.Header.Add("Accept", "application/json")
 
+	// The token request carries the api secret, it bypasses the middlewares
+	// so they never see the credentials.
 	responseBody, err := client.runRequest(req)
 	if err != nil {
-		return "", fmt.Errorf("%w, %w", ErrGetTokenFailed, err)
+		return "", 0, fmt.Errorf("%w, %w", ErrGetTokenFailed, err)
 	}
 
 	var reponseData struct {
@@ -145,10 +207,30 @@ func (client *Client) GetToken(ctx context.Context, options ...ClientOptions) (s
 
 	err = json.Unmarshal(responseBody, &reponseData)
 	if err != nil {
-		return "", fmt.Errorf("%w: %w", ErrGetTokenFailed, err)
+		return "", 0, fmt.Errorf("%w: %w", ErrGetTokenFailed, err)
 	}
 
-	return reponseData.AccessToken, nil
+	return reponseData.AccessToken, time.Duration(reponseData.ExpiresIn) * time.Second, nil
+}
+
+// authorize sets the bearer token on the request. A token passed in with
+// WithAPIToken is used as is, otherwise the token is taken from the token
+// source which fetches and refreshes it using the api key and api secret.
+func (client *Client) authorize(ctx context.Context, req *http.Request) error {
+	token := client.apiToken
+
+	if token == "" && client.ApiKey != "" && client.ApiSecret != "" {
+		var err error
+
+		token, err = client.tokens.Token(ctx, client.requestToken)
+		if err != nil {
+			return err
+		}
+	}
+
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	return nil
 }
 
 // Criteria Search Locates possible entities from the Dun & Bradstreet Data Cloud using
@@ -169,36 +251,27 @@ func (client *Client) GetToken(ctx context.Context, options ...ClientOptions) (s
 // # Documentation
 //
 // - https://directplus.documentation.dnb.com/openAPI.html?apiID=searchCriteria
-func (client *Client) CriteriaSearch(ctx context.Context, options ...ClientOptions) (*api_response.CompanySearch, error) {
+func (client *Client) CriteriaSearch(ctx context.Context, options ...RequestOptions) (*api_response.CompanySearch, error) {
 	searchResults := &api_response.CompanySearch{}
-	client.RequestBody.CompanySearch = &CompanySearchRequest{}
 
-	client.loadOptions(options...)
+	reqBody := newRequestBody(options...)
 
-	reqBytes, err := json.Marshal(client.RequestBody.CompanySearch)
+	err := client.validate(func() error {
+		return reqBody.CompanySearch.validate(CriteriaSearchMaxResults)
+	})
 	if err != nil {
 		return searchResults, fmt.Errorf("%w, %w", ErrSearchCriteriaFailed, err)
 	}
 
-	reqURL := client.BaseURL + CriteriaSearchURL
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewBuffer(reqBytes))
+	err = client.do(ctx, http.MethodPost, client.BaseURL+CriteriaSearchURL, reqBody.CompanySearch, RequestInfo{
+		DUNS:       reqBody.CompanySearch.DUNS.Normalize().String(),
+		SearchTerm: reqBody.CompanySearch.SearchTerm,
+	}, searchResults)
 	if err != nil {
 		return searchResults, fmt.Errorf("%w, %w", ErrSearchCriteriaFailed, err)
 	}
 
-	req.Header.Add("Authorization", "Bearer "+client.apiToken)
-	req.Header.Add("Content-Type", "application/json")
-
-	fmt.Println("Request URL: ", req.URL)
-	responseBody, err := client.runRequest(req)
-	if err != nil {
-		return searchResults, fmt.Errorf("%w, %w", ErrSearchCriteriaFailed, err)
-	}
-
-	err = json.Unmarshal(responseBody, searchResults)
-	if err != nil {
-		return searchResults, fmt.Errorf("%w, %w", ErrSearchCriteriaFailed, err)
-	}
+	client.recordCandidates(CriteriaSearchURL, searchResults.Base)
 
 	return searchResults, nil
 }
@@ -210,13 +283,15 @@ func (client *Client) CriteriaSearch(ctx context.Context, options ...ClientOptio
 //
 // - ctx
 //
-// - options: allows configuring the search
-//
 // - searchTerm: 2 to 30 characters used to find entities by its primary name or
-// one of its tradestyle names.
+// one of its tradestyle names, overrides the search term of the request when set.
 //
 // - countrCode: The 2-letter country/market code defined by the International Organization
-// for Standardization (ISO) ISO 3166-1 scheme identifying the country of the entity.
+// for Standardization (ISO) ISO 3166-1 scheme identifying the country of the entity,
+// overrides the country of the request when set.
+//
+// - options: allows passing the full request with WithTypeheadSearchRequest, every
+// populated field is sent as a query parameter
 //
 // # Returns
 //
@@ -227,39 +302,39 @@ func (client *Client) CriteriaSearch(ctx context.Context, options ...ClientOptio
 // # Documentation
 //
 // - https://directplus.documentation.dnb.com/openAPI.html?apiID=searchTypeahead
-func (client *Client) TypeheadSearch(ctx context.Context, searchTerm string, countryCode string, options ...ClientOptions) (*api_response.TypeheadSearch, error) {
+func (client *Client) TypeheadSearch(ctx context.Context, searchTerm string, countryCode string, options ...RequestOptions) (*api_response.TypeheadSearch, error) {
 	searchResults := &api_response.TypeheadSearch{}
 
-	client.loadOptions(options...)
+	reqBody := newRequestBody(options...)
+	request := reqBody.TypeheadSearch
 
-	reqURL, err := url.Parse(client.BaseURL + TypeheadSearchURL)
-	if err != nil {
-		return searchResults, fmt.Errorf("%w, %w", ErrTypeheadSearchFailed, err)
+	if searchTerm != "" {
+		request.SearchTerm = searchTerm
 	}
 
-	params := reqURL.Query()
-	params.Add("searchTerm", searchTerm)
-	params.Add("countryISOAlpha2Code", countryCode)
-	reqURL.RawQuery = params.Encode()
+	if countryCode != "" {
+		request.CountryISOAlpha2Code = countryCode
+	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+	err := client.validate(request.Validate)
 	if err != nil {
 		return searchResults, fmt.Errorf("%w, %w", ErrTypeheadSearchFailed, err)
 	}
 
-	req.Header.Add("Authorization", "Bearer "+client.apiToken)
-	req.Header.Add("Content-Type", "application/json")
-
-	responseBody, err := client.runRequest(req)
+	reqURL, err := url.Parse(client.BaseURL + TypeheadSearchURL)
 	if err != nil {
 		return searchResults, fmt.Errorf("%w, %w", ErrTypeheadSearchFailed, err)
 	}
 
-	err = json.Unmarshal(responseBody, searchResults)
+	reqURL.RawQuery = request.queryParams().Encode()
+
+	err = client.do(ctx, http.MethodGet, reqURL.String(), nil, RequestInfo{SearchTerm: request.SearchTerm}, searchResults)
 	if err != nil {
 		return searchResults, fmt.Errorf("%w, %w", ErrTypeheadSearchFailed, err)
 	}
 
+	client.recordCandidates(TypeheadSearchURL, searchResults.Base)
+
 	return searchResults, nil
 }
 
@@ -281,42 +356,33 @@ func (client *Client) TypeheadSearch(ctx context.Context, searchTerm string, cou
 // # Documentation
 //
 // - https://directplus.documentation.dnb.com/openAPI.html?apiID=searchCompanyList
-func (client *Client) CompanyListSearch(ctx context.Context, options ...ClientOptions) (*api_response.CompanySearch, error) {
+func (client *Client) CompanyListSearch(ctx context.Context, options ...RequestOptions) (*api_response.CompanySearch, error) {
 	searchResults := &api_response.CompanySearch{}
-	client.RequestBody.CompanySearch = &CompanySearchRequest{}
-
-	client.loadOptions(options...)
 
-	reqBytes, err := json.Marshal(client.RequestBody.CompanySearch)
-	if err != nil {
-		return searchResults, fmt.Errorf("%w, %w", ErrCompanyListFailed, err)
-	}
+	reqBody := newRequestBody(options...)
 
-	reqURL := client.BaseURL + CompanyListURL
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewBuffer(reqBytes))
+	err := client.validate(reqBody.CompanySearch.Validate)
 	if err != nil {
 		return searchResults, fmt.Errorf("%w, %w", ErrCompanyListFailed, err)
 	}
 
-	req.Header.Add("Authorization", "Bearer "+client.apiToken)
-	req.Header.Add("Content-Type", "application/json")
-
-	responseBody, err := client.runRequest(req)
+	err = client.do(ctx, http.MethodPost, client.BaseURL+CompanyListURL, reqBody.CompanySearch, RequestInfo{
+		DUNS:       reqBody.CompanySearch.DUNS.Normalize().String(),
+		SearchTerm: reqBody.CompanySearch.SearchTerm,
+	}, searchResults)
 	if err != nil {
 		return searchResults, fmt.Errorf("%w, %w", ErrCompanyListFailed, err)
 	}
 
-	err = json.Unmarshal(responseBody, searchResults)
-	if err != nil {
-		return searchResults, fmt.Errorf("%w, %w", ErrCompanyListFailed, err)
-	}
+	client.recordCandidates(CompanyListURL, searchResults.Base)
 
 	return searchResults, nil
 }
 
 // Search Contact allows D&B Direct+ customers to search for individuals using several parameters.
 // This function will perfom both standard and premium search depending on the data passed in the
-// client options contact search request body. Refer to documentation for details.
+// contact search request body passed in the request options. Refer to documentation for details.
+// SearchContactsStandard and SearchContactsPremium make the mode explicit.
 //
 // # Parameters
 //
@@ -335,32 +401,24 @@ func (client *Client) CompanyListSearch(ctx context.Context, options ...ClientOp
 // - Contact Search Standard: https://directplus.documentation.dnb.com/openAPI.html?apiID=searchContactsStandard
 //
 // - Contact Search Premium: https://directplus.documentation.dnb.com/openAPI.html?apiID=searchContactsPremium
-func (client *Client) SearchContact(ctx context.Context, options ...ClientOptions) (*api_response.ContactSearch, error) {
+func (client *Client) SearchContact(ctx context.Context, options ...RequestOptions) (*api_response.ContactSearch, error) {
 	searchResults := &api_response.ContactSearch{}
-	client.RequestBody.ContactSearch = &ContactSearchRequest{}
 
-	client.loadOptions(options...)
+	reqBody := newRequestBody(options...)
 
-	reqBytes, err := json.Marshal(client.RequestBody.ContactSearch)
+	err := client.validate(reqBody.ContactSearch.Validate)
 	if err != nil {
 		return searchResults, fmt.Errorf("%w, %w", ErrContactSearchFailed, err)
 	}
 
-	reqUrl := client.BaseURL + ContactSearchURL
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqUrl, bytes.NewBuffer(reqBytes))
+	err = client.do(ctx, http.MethodPost, client.BaseURL+ContactSearchURL, reqBody.ContactSearch, RequestInfo{
+		DUNS: reqBody.ContactSearch.Duns.Normalize().String(),
+	}, searchResults)
 	if err != nil {
 		return searchResults, fmt.Errorf("%w, %w", ErrContactSearchFailed, err)
 	}
 
-	responseBody, err := client.runRequest(req)
-	if err != nil {
-		return searchResults, fmt.Errorf("%w, %w", ErrContactSearchFailed, err)
-	}
-
-	err = json.Unmarshal(responseBody, searchResults)
-	if err != nil {
-		return searchResults, fmt.Errorf("%w, %w", ErrContactSearchFailed, err)
-	}
+	client.recordCandidates(ContactSearchURL, searchResults.Base)
 
 	return searchResults, nil
 }
@@ -373,7 +431,7 @@ func (client *Client) SearchContact(ctx context.Context, options ...ClientOption
 //
 // - contactID:1 to 16 characters used to find entities by a unique ID assigned to the contact.
 //
-// - options: allows configuring the search
+// - options: allows setting the page with WithPage
 //
 // # Returns
 //
@@ -384,21 +442,8 @@ func (client *Client) SearchContact(ctx context.Context, options ...ClientOption
 // # Documentation
 //
 // - https://directplus.documentation.dnb.com/openAPI.html?apiID=searchContactsGet
-func (client *Client) GetContactByID(ctx context.Context, contactID string, options ...ClientOptions) (*api_response.ContactSearch, error) {
-	searchResults := &api_response.ContactSearch{}
-
-	client.loadOptions(options...)
-
-	reqURL, err := url.Parse(client.BaseURL + ContactSearchURL)
-	if err != nil {
-		return searchResults, fmt.Errorf("%w, %w", ErrGetContactsFailed, err)
-	}
-
-	params := reqURL.Query()
-	params.Add("contactID", contactID)
-	reqURL.RawQuery = params.Encode()
-
-	return client.getContact(ctx, reqURL)
+func (client *Client) GetContactByID(ctx context.Context, contactID string, options ...RequestOptions) (*api_response.ContactSearch, error) {
+	return client.getContactBy(ctx, "contactID", contactID, RequestInfo{}, options...)
 }
 
 // GetContactsByEmail will return a single contact from the D&B Direct+ API based on the contact email
@@ -409,6 +454,8 @@ func (client *Client) GetContactByID(ctx context.Context, contactID string, opti
 //
 // - email: 1 to 128 characters used to find entities by the contact's email address.
 //
+// - options: allows setting the page with WithPage
+//
 // # Returns
 //
 // - ContactSearch: contact search results
@@ -418,21 +465,8 @@ func (client *Client) GetContactByID(ctx context.Context, contactID string, opti
 // # Documentation
 //
 // - https://directplus.documentation.dnb.com/openAPI.html?apiID=searchContactsGet
-func (client *Client) GetContactByEmail(ctx context.Context, email string, options ...ClientOptions) (*api_response.ContactSearch, error) {
-	searchResults := &api_response.ContactSearch{}
-
-	client.loadOptions(options...)
-
-	reqURL, err := url.Parse(client.BaseURL + ContactSearchURL)
-	if err != nil {
-		return searchResults, fmt.Errorf("%w, %w", ErrGetContactsFailed, err)
-	}
-
-	params := reqURL.Query()
-	params.Add("contactEmail", email)
-	reqURL.RawQuery = params.Encode()
-
-	return client.getContact(ctx, reqURL)
+func (client *Client) GetContactByEmail(ctx context.Context, email string, options ...RequestOptions) (*api_response.ContactSearch, error) {
+	return client.getContactBy(ctx, "contactEmail", email, RequestInfo{}, options...)
 }
 
 // GetContactsByDUNS will return a single contact from the D&B Direct+ API based on the contact DUNS
@@ -443,6 +477,8 @@ func (client *Client) GetContactByEmail(ctx context.Context, email string, optio
 //
 // - duns: D-U-N-S number to be used for search (mandatory when Searching by D-U-N-S, otherwise optional)
 //
+// - options: allows setting the page with WithPage
+//
 // # Returns
 //
 // - ContactSearch: contact search results
@@ -452,10 +488,35 @@ func (client *Client) GetContactByEmail(ctx context.Context, email string, optio
 // # Documentation
 //
 // - https://directplus.documentation.dnb.com/openAPI.html?apiID=searchContactsGetByDuns
-func (client *Client) GetContactByDUNS(ctx context.Context, duns string, options ...ClientOptions) (*api_response.ContactSearch, error) {
+func (client *Client) GetContactByDUNS(ctx context.Context, duns DUNS, options ...RequestOptions) (*api_response.ContactSearch, error) {
+	duns = duns.Normalize()
+
+	err := client.validate(func() error {
+		return (&ContactSearchRequest{Duns: duns}).Validate()
+	})
+	if err != nil {
+		return &api_response.ContactSearch{}, fmt.Errorf("%w, %w", ErrGetContactsFailed, err)
+	}
+
+	return client.getContactBy(ctx, "duns", duns.String(), RequestInfo{DUNS: duns.String()}, options...)
+}
+
+// getContactBy looks up contacts by a single query parameter, applying the
+// page set with WithPage.
+func (client *Client) getContactBy(ctx context.Context, key string, value string, info RequestInfo, options ...RequestOptions) (*api_response.ContactSearch, error) {
 	searchResults := &api_response.ContactSearch{}
 
-	client.loadOptions(options...)
+	reqBody := newRequestBody(options...)
+
+	request := &ContactSearchRequest{
+		PageNumber: reqBody.ContactSearch.PageNumber,
+		PageSize:   reqBody.ContactSearch.PageSize,
+	}
+
+	err := client.validate(request.Validate)
+	if err != nil {
+		return searchResults, fmt.Errorf("%w, %w", ErrGetContactsFailed, err)
+	}
 
 	reqURL, err := url.Parse(client.BaseURL + ContactSearchURL)
 	if err != nil {
@@ -463,76 +524,191 @@ func (client *Client) GetContactByDUNS(ctx context.Context, duns string, options
 	}
 
 	params := reqURL.Query()
-	params.Add("duns", duns)
+	params.Add(key, value)
+
+	if request.PageNumber > 0 {
+		params.Add("pageNumber", strconv.Itoa(request.PageNumber))
+	}
+
+	if request.PageSize > 0 {
+		params.Add("pageSize", strconv.Itoa(request.PageSize))
+	}
+
 	reqURL.RawQuery = params.Encode()
 
-	return client.getContact(ctx, reqURL)
+	return client.getContact(ctx, reqURL, info)
 }
 
-func (client *Client) getContact(ctx context.Context, reqURl *url.URL) (*api_response.ContactSearch, error) {
+func (client *Client) getContact(ctx context.Context, reqURL *url.URL, info RequestInfo) (*api_response.ContactSearch, error) {
 	searchResults := &api_response.ContactSearch{}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURl.String(), nil)
+	err := client.do(ctx, http.MethodGet, reqURL.String(), nil, info, searchResults)
 	if err != nil {
 		return searchResults, fmt.Errorf("%w, %w", ErrGetContactsFailed, err)
 	}
 
-	req.Header.Add("Authorization", "Bearer "+client.apiToken)
-	req.Header.Add("Content-Type", "application/json")
+	client.recordCandidates(ContactSearchURL, searchResults.Base)
 
-	responseBody, err := client.runRequest(req)
-	if err != nil {
-		return searchResults, fmt.Errorf("%w, %w", ErrGetContactsFailed, err)
+	return searchResults, nil
+}
+
+func (client *Client) runRequest(req *http.Request) ([]byte, error) {
+	info := requestInfoFrom(req.Context())
+	info.Method = req.Method
+	info.Endpoint = client.endpoint(req)
+
+	ctx := client.startHooks(req.Context(), &info)
+
+	start := time.Now()
+	res, body, attempts, err := client.retryRequest(req.WithContext(ctx))
+	latency := time.Since(start)
+
+	statusCode := 0
+	if res != nil {
+		statusCode = res.StatusCode
 	}
 
-	err = json.Unmarshal(responseBody, searchResults)
-	if err != nil {
-		return searchResults, fmt.Errorf("%w, %w", ErrGetContactsFailed, err)
+	client.recordRequest(info.Endpoint, statusCode, err, latency)
+	client.endHooks(ctx, &info, &RequestResult{
+		StatusCode: statusCode,
+		Attempts:   attempts,
+		Latency:    latency,
+		Err:        err,
+	}, body)
+
+	return body, err
+}
+
+// retryRequest sends the request, retrying it according to the retry policy.
+// It returns the last response, its body on success and the number of attempts made.
+func (client *Client) retryRequest(req *http.Request) (*http.Response, []byte, int, error) {
+	policy := client.retryPolicy
+
+	for attempt := 1; ; attempt++ {
+		err := client.limiter.Wait(req.Context(), client.endpoint(req))
+		if err != nil {
+			return nil, nil, attempt - 1, err
+		}
+
+		start := time.Now()
+		res, body, err := client.sendRequest(req, attempt)
+		latency := time.Since(start)
+
+		if err == nil && res.StatusCode == http.StatusOK {
+			client.logAttempt(req, attempt, res, body, nil, latency, false)
+
+			return res, body, attempt, nil
+		}
+
+		retrying := attempt < policy.MaxAttempts && client.canRetry(req, res, err)
+		client.logAttempt(req, attempt, res, body, err, latency, retrying)
+
+		if retrying {
+			var header http.Header
+			if res != nil {
+				header = res.Header
+			}
+
+			err = sleep(req.Context(), policy.backoff(attempt, header))
+			if err != nil {
+				return res, nil, attempt, err
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, attempt, err
+		}
+
+		return res, nil, attempt, client.newAPIError(client.endpoint(req), res.StatusCode, body)
 	}
+}
 
-	return searchResults, nil
+// RateLimitWait returns how long a request to the endpoint would currently
+// wait for the client side rate limiter, useful for reporting throttling.
+//
+// # Parameters
+//
+// - endpoint: endpoint constant the limit is configured for, e.g. CriteriaSearchURL
+//
+// # Returns
+//
+// - time.Duration: wait time, zero when the endpoint is not throttled
+func (client *Client) RateLimitWait(endpoint string) time.Duration {
+	return client.limiter.WaitTime(endpoint)
 }
 
-func (client *Client) runRequest(req *http.Request) ([]byte, error) {
+// endpoint returns the path of the request relative to the base url, which
+// matches the endpoint constants.
+func (client *Client) endpoint(req *http.Request) string {
+	// Endpoints with path parameters name themselves so the parameters do
+	// not end up in metrics, rate limits and logs.
+	if info := requestInfoFrom(req.Context()); info.Endpoint != "" {
+		return info.Endpoint
+	}
 
-	res, err := http.DefaultClient.Do(req)
+	baseURL, err := url.Parse(client.BaseURL)
 	if err != nil {
-		return nil, err
+		return req.URL.Path
 	}
 
-	defer res.Body.Close()
+	return strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(baseURL.Path, "/"))
+}
 
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
+// sendRequest performs a single attempt of the request. Retried attempts get
+// a fresh copy of the request body.
+func (client *Client) sendRequest(req *http.Request, attempt int) (*http.Response, []byte, error) {
+	ctx := req.Context()
+
+	if client.retryPolicy.AttemptTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, client.retryPolicy.AttemptTimeout)
+		defer cancel()
 	}
 
-	if res.StatusCode != http.StatusOK {
-		errorResponse := &api_response.ErrorResponse{}
+	attemptReq := req.WithContext(ctx)
 
-		err = json.Unmarshal(body, errorResponse)
+	if attempt > 1 && req.GetBody != nil {
+		body, err := req.GetBody()
 		if err != nil {
-			return nil, fmt.Errorf("%w, %d", ErrRequestFailed, res.StatusCode)
+			return nil, nil, err
 		}
 
-		if client.BaseURL == BaseURLV3 {
-			return nil, fmt.Errorf("%w, %s", ErrRequestFailed, errorResponse.ErrorDescription)
-		}
+		attemptReq.Body = body
+	}
 
-		if client.BaseURL == BaseURLV1 {
-			return nil, fmt.Errorf("%w, %s", ErrRequestFailed, errorResponse.ErrorMessage)
-		}
+	res, err := client.httpClient.Do(attemptReq)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return body, nil
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res, body, nil
 }
 
-func (client *Client) loadOptions(options ...ClientOptions) {
-	if len(options) > 0 {
-		client.options = options
+// canRetry reports whether a failed attempt can be repeated. Transport errors
+// are retried as long as the request context is still alive, responses only
+// when their status is retryable.
+func (client *Client) canRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
 	}
 
-	for _, option := range client.options {
-		option(client)
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
 	}
+
+	if err != nil {
+		return true
+	}
+
+	return client.retryPolicy.isRetryableStatus(res.StatusCode)
 }
@@ -1,16 +1,26 @@
 package dnbclient_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/h2non/gock"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/assert"
 	"github.com/struki84/dnbclient"
+	"github.com/struki84/dnbclient/api_response"
 )
 
 func TestGetToken(t *testing.T) {
@@ -23,7 +33,7 @@ func TestGetToken(t *testing.T) {
 		defer gock.Off()
 
 		gock.New(dnbclient.BaseURLV3).
-			Post(dnbclient.AuthURL).
+			Post("/v3" + dnbclient.AuthURL).
 			Reply(http.StatusOK).
 			JSON(map[string]any{"access_token": "test_token", "token_type": "Bearer", "expires_in": 3600})
 
@@ -38,7 +48,7 @@ func TestGetToken(t *testing.T) {
 		defer gock.Off()
 
 		gock.New(dnbclient.BaseURLV3).
-			Post(dnbclient.AuthURL).
+			Post("/v3" + dnbclient.AuthURL).
 			Reply(http.StatusUnauthorized).
 			JSON(map[string]string{"error": "invalid_request"})
 
@@ -64,12 +74,13 @@ func TestGetToken(t *testing.T) {
 	}
 
 	t.Run("Get Token - functional test", func(t *testing.T) {
-		token, err := client.GetToken(
-			context.Background(),
+		client, _ := dnbclient.NewClient(
 			dnbclient.WithBaseURL(dnbclient.BaseURLV3),
 			dnbclient.WithTokens(os.Getenv("API_KEY"), os.Getenv("API_SECRET")),
 		)
 
+		token, err := client.GetToken(context.Background())
+
 		err = saveToken(token)
 		if err != nil {
 			t.Error(err)
@@ -79,297 +90,2314 @@ func TestGetToken(t *testing.T) {
 	})
 }
 
-func TestCriteriaSearch(t *testing.T) {
+func TestTokenRefresh(t *testing.T) {
 
 	client, _ := dnbclient.NewClient(
-		dnbclient.WithCredentials("test_username", "test_password"),
+		dnbclient.WithTokens("test_key", "test_secret"),
 	)
 
-	t.Run("Unit Test: Successful Criteria Search", func(t *testing.T) {
+	t.Run("Unit Test: Token is reused across searches", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post("/v3" + dnbclient.AuthURL).
+			Times(1).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"access_token": "test_token", "token_type": "Bearer", "expires_in": 3600})
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			MatchHeader("Authorization", "Bearer test_token").
+			Times(3).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		for i := 0; i < 3; i++ {
+			_, err := client.CriteriaSearch(context.Background())
+			assert.NoError(t, err)
+		}
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Expired token is refreshed", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post("/v3" + dnbclient.AuthURL).
+			Times(1).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"access_token": "expired_token", "token_type": "Bearer", "expires_in": 0})
+
+		gock.New(dnbclient.BaseURLV1).
+			Post("/v3" + dnbclient.AuthURL).
+			Times(1).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"access_token": "fresh_token", "token_type": "Bearer", "expires_in": 3600})
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.ContactSearchURL).
+			MatchHeader("Authorization", "Bearer fresh_token").
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		token, err := client.GetToken(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "expired_token", token)
+
+		_, err = client.GetContactByDUNS(context.Background(), "804735132")
+		assert.NoError(t, err)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Token is requested from the token url", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New("https://auth.example.com").
+			Post("/oauth/token").
+			Reply(http.StatusOK).
+			JSON(map[string]any{"access_token": "test_token", "token_type": "Bearer", "expires_in": 3600})
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithTokens("test_key", "test_secret"),
+			dnbclient.WithTokenURL("https://auth.example.com/oauth/token"),
+		)
+
+		token, err := client.GetToken(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "test_token", token)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Hanging token request times out and is retried", func(t *testing.T) {
+		var tokenRequests atomic.Int32
+		release := make(chan struct{})
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/token" {
+				w.Write([]byte(`{"transactionDetail": {"transactionID": "test_transactionID"}}`))
+				return
+			}
+
+			if tokenRequests.Add(1) == 1 {
+				<-release
+				return
+			}
+
+			w.Write([]byte(`{"access_token": "test_token", "expires_in": 3600}`))
+		}))
+		defer server.Close()
+		defer close(release)
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithHTTPClient(server.Client()),
+			dnbclient.WithBaseURL(server.URL),
+			dnbclient.WithTokenURL(server.URL+"/token"),
+			dnbclient.WithTokens("test_key", "test_secret"),
+			dnbclient.WithTokenTimeout(50*time.Millisecond),
+		)
+
+		_, err := client.CriteriaSearch(context.Background())
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		searchResults, err := client.CriteriaSearch(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+
+		assert.Equal(t, int32(2), tokenRequests.Load())
+	})
+}
+
+func TestHTTPClient(t *testing.T) {
+
+	t.Run("Unit Test: Requests use the configured transport", func(t *testing.T) {
+		transport := &stubTransport{
+			status: http.StatusOK,
+			body:   `{"transactionDetail": {"transactionID": "test_transactionID"}}`,
+		}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithTransport(transport),
+		)
+
+		searchResults, err := client.TypeheadSearch(
+			context.Background(),
+			"test_search",
+			"US",
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+		assert.Len(t, transport.requests, 1)
+		assert.Equal(t, "/v1"+dnbclient.TypeheadSearchURL, transport.requests[0].URL.Path)
+	})
+
+	t.Run("Unit Test: Requests use the configured http client", func(t *testing.T) {
+		transport := &stubTransport{
+			status: http.StatusOK,
+			body:   `{"transactionDetail": {"transactionID": "test_transactionID"}}`,
+		}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithHTTPClient(&http.Client{Transport: transport}),
+		)
+
+		_, err := client.SearchContact(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, transport.requests, 1)
+	})
+
+	t.Run("Unit Test: Transport is kept when the HTTP client is set after it", func(t *testing.T) {
+		transport := &stubTransport{
+			status: http.StatusOK,
+			body:   `{"transactionDetail": {"transactionID": "test_transactionID"}}`,
+		}
+
+		httpClient := &http.Client{Timeout: time.Second}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithTransport(transport),
+			dnbclient.WithHTTPClient(httpClient),
+			dnbclient.WithAPIToken("test_token"),
+		)
+
+		_, err := client.CriteriaSearch(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, transport.requests, 1)
+		assert.Nil(t, httpClient.Transport)
+	})
+
+	t.Run("Unit Test: Nil HTTP client falls back to the default client", func(t *testing.T) {
+		transport := &stubTransport{
+			status: http.StatusOK,
+			body:   `{"transactionDetail": {"transactionID": "test_transactionID"}}`,
+		}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithHTTPClient(nil),
+			dnbclient.WithTransport(transport),
+			dnbclient.WithAPIToken("test_token"),
+		)
+
+		_, err := client.CriteriaSearch(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, transport.requests, 1)
+	})
+}
+
+func TestRetryPolicy(t *testing.T) {
+
+	client, _ := dnbclient.NewClient(
+		dnbclient.WithRetryPolicy(dnbclient.RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     10 * time.Millisecond,
+			Jitter:         0.5,
+		}),
+	)
+
+	t.Run("Unit Test: Unavailable response is retried with the same body", func(t *testing.T) {
+		defer gock.Off()
+
+		requestBody := map[string]any{"searchTerm": "test_search_term"}
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			JSON(requestBody).
+			Reply(http.StatusServiceUnavailable).
+			JSON(map[string]string{"errorMessage": "service_unavailable"})
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			JSON(requestBody).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		searchResults, err := client.CriteriaSearch(
+			context.Background(),
+			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+				SearchTerm: "test_search_term",
+			}),
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Throttled response honours Retry-After", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.TypeheadSearchURL).
+			Reply(http.StatusTooManyRequests).
+			SetHeader("Retry-After", "0").
+			JSON(map[string]string{"errorMessage": "too_many_requests"})
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.TypeheadSearchURL).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		searchResults, err := client.TypeheadSearch(context.Background(), "test_search", "US")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Retries stop after max attempts", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.TypeheadSearchURL).
+			Times(3).
+			Reply(http.StatusGatewayTimeout).
+			JSON(map[string]string{"errorMessage": "gateway_timeout"})
+
+		_, err := client.TypeheadSearch(context.Background(), "test_search", "US")
+
+		assert.ErrorIs(t, err, dnbclient.ErrRequestFailed)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Client errors are not retried", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.TypeheadSearchURL).
+			Times(1).
+			Reply(http.StatusUnauthorized).
+			JSON(map[string]string{"errorMessage": "invalid_request"})
+
+		_, err := client.TypeheadSearch(context.Background(), "test_search", "US")
+
+		assert.Error(t, err)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+}
+
+func TestRateLimit(t *testing.T) {
+
+	client, _ := dnbclient.NewClient(
+		dnbclient.WithRateLimit(dnbclient.TypeheadSearchURL, 20, 1),
+	)
+
+	t.Run("Unit Test: Requests are throttled per endpoint", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.TypeheadSearchURL).
+			Times(2).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		start := time.Now()
+		for i := 0; i < 2; i++ {
+			_, err := client.TypeheadSearch(context.Background(), "test_search", "US")
+			assert.NoError(t, err)
+		}
+
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+		assert.Greater(t, client.RateLimitWait(dnbclient.TypeheadSearchURL), time.Duration(0))
+		assert.Equal(t, time.Duration(0), client.RateLimitWait(dnbclient.CriteriaSearchURL))
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+}
+
+func TestAPIError(t *testing.T) {
+
+	client, _ := dnbclient.NewClient()
+
+	t.Run("Unit Test: Direct+ error is returned as APIError", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			Reply(http.StatusBadRequest).
+			JSON(map[string]any{
+				"transactionDetail": map[string]string{"transactionID": "test_transactionID"},
+				"error":             map[string]string{"errorCode": "10001", "errorMessage": "test_error_message"},
+			})
+
+		_, err := client.CriteriaSearch(context.Background())
+
+		assert.ErrorIs(t, err, dnbclient.ErrSearchCriteriaFailed)
+		assert.ErrorIs(t, err, dnbclient.ErrRequestFailed)
+
+		var apiError *dnbclient.APIError
+		assert.ErrorAs(t, err, &apiError)
+		assert.Equal(t, http.StatusBadRequest, apiError.StatusCode)
+		assert.Equal(t, "10001", apiError.ErrorCode)
+		assert.Equal(t, "test_error_message", apiError.Message)
+		assert.Equal(t, "test_transactionID", apiError.TransactionID)
+		assert.Equal(t, dnbclient.CriteriaSearchURL, apiError.Endpoint)
+		assert.False(t, apiError.Retryable)
+		assert.NotEmpty(t, apiError.Body)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Authentication error is returned as APIError", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post("/v3" + dnbclient.AuthURL).
+			Reply(http.StatusUnauthorized).
+			JSON(map[string]string{"error": "invalid_client", "error_description": "test_error_description"})
+
+		_, err := client.GetToken(context.Background())
+
+		assert.ErrorIs(t, err, dnbclient.ErrGetTokenFailed)
+
+		var apiError *dnbclient.APIError
+		assert.ErrorAs(t, err, &apiError)
+		assert.Equal(t, http.StatusUnauthorized, apiError.StatusCode)
+		assert.Equal(t, "test_error_description", apiError.Description)
+		assert.Equal(t, dnbclient.AuthURL, apiError.Endpoint)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Throttled response is retryable", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.ContactSearchURL).
+			Reply(http.StatusTooManyRequests).
+			BodyString("Too Many Requests")
+
+		_, err := client.GetContactByEmail(context.Background(), "test_email")
+
+		var apiError *dnbclient.APIError
+		assert.ErrorAs(t, err, &apiError)
+		assert.Equal(t, http.StatusTooManyRequests, apiError.StatusCode)
+		assert.True(t, apiError.Retryable)
+		assert.Equal(t, "Too Many Requests", string(apiError.Body))
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+}
+
+func TestConcurrentRequests(t *testing.T) {
+
+	client, _ := dnbclient.NewClient()
+
+	t.Run("Unit Test: Concurrent searches keep their own request bodies", func(t *testing.T) {
+		defer gock.Off()
+
+		for i := 0; i < 10; i++ {
+			gock.New(dnbclient.BaseURLV1).
+				Post(dnbclient.CriteriaSearchURL).
+				JSON(map[string]any{"searchTerm": fmt.Sprintf("test_search_term_%d", i)}).
+				Reply(http.StatusOK).
+				JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": fmt.Sprintf("criteria_%d", i)}})
+
+			gock.New(dnbclient.BaseURLV1).
+				Post(dnbclient.ContactSearchURL).
+				JSON(map[string]any{"contactEmail": fmt.Sprintf("test_email_%d", i)}).
+				Reply(http.StatusOK).
+				JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": fmt.Sprintf("contact_%d", i)}})
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(2)
+
+			go func() {
+				defer wg.Done()
+
+				searchResults, err := client.CriteriaSearch(
+					context.Background(),
+					dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+						SearchTerm: fmt.Sprintf("test_search_term_%d", i),
+					}),
+				)
+
+				assert.NoError(t, err)
+				assert.Equal(t, fmt.Sprintf("criteria_%d", i), searchResults.TransactionDetail.TransactionID)
+			}()
+
+			go func() {
+				defer wg.Done()
+
+				searchResults, err := client.SearchContact(
+					context.Background(),
+					dnbclient.WithContactSearchRequest(&dnbclient.ContactSearchRequest{
+						ContactEmail: fmt.Sprintf("test_email_%d", i),
+					}),
+				)
+
+				assert.NoError(t, err)
+				assert.Equal(t, fmt.Sprintf("contact_%d", i), searchResults.TransactionDetail.TransactionID)
+			}()
+		}
+		wg.Wait()
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Options of one call do not leak into the next", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			JSON(map[string]any{"searchTerm": "test_search_term"}).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			JSON(map[string]any{}).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		_, err := client.CriteriaSearch(
+			context.Background(),
+			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+				SearchTerm: "test_search_term",
+			}),
+		)
+		assert.NoError(t, err)
+
+		_, err = client.CriteriaSearch(context.Background())
+		assert.NoError(t, err)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Nil search requests are ignored", func(t *testing.T) {
+		transport := &stubTransport{
+			status: http.StatusOK,
+			body:   `{"transactionDetail": {"transactionID": "test_transactionID"}}`,
+		}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithTransport(transport),
+			dnbclient.WithAPIToken("test_token"),
+		)
+
+		_, err := client.CriteriaSearch(context.Background(), dnbclient.WithCompanySerchRequest(nil))
+		assert.NoError(t, err)
+
+		_, err = client.SearchContact(context.Background(), dnbclient.WithContactSearchRequest(nil))
+		assert.NoError(t, err)
+
+		_, err = client.TypeheadSearch(context.Background(), "test_search", "US", dnbclient.WithTypeheadSearchRequest(nil))
+		assert.NoError(t, err)
+
+		assert.Len(t, transport.requests, 3)
+	})
+}
+
+func TestLogger(t *testing.T) {
+
+	t.Run("Unit Test: Requests are logged without credentials and personal data", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.ContactSearchURL).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		var logs bytes.Buffer
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithAPIToken("test_api_token"),
+			dnbclient.WithLogger(slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))),
+		)
+
+		_, err := client.GetContactByEmail(context.Background(), "test_contact_email")
+		assert.NoError(t, err)
+
+		var entry map[string]any
+		assert.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+		assert.Equal(t, "DEBUG", entry["level"])
+		assert.Equal(t, http.MethodGet, entry["method"])
+		assert.Equal(t, dnbclient.ContactSearchURL, entry["endpoint"])
+		assert.Equal(t, float64(http.StatusOK), entry["status"])
+		assert.Equal(t, float64(1), entry["attempt"])
+		assert.Equal(t, "test_transactionID", entry["transaction_id"])
+		assert.Contains(t, entry, "latency")
+
+		assert.NotContains(t, logs.String(), "test_api_token")
+		assert.NotContains(t, logs.String(), "test_contact_email")
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Failed requests are logged at the configured level", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			Reply(http.StatusBadRequest).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		var logs bytes.Buffer
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithLogger(slog.New(slog.NewJSONHandler(&logs, nil))),
+			dnbclient.WithLogLevels(dnbclient.LogLevels{
+				Success: slog.LevelDebug,
+				Retry:   slog.LevelInfo,
+				Failure: slog.LevelWarn,
+			}),
+		)
+
+		_, err := client.CriteriaSearch(context.Background())
+		assert.Error(t, err)
+
+		var entry map[string]any
+		assert.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+		assert.Equal(t, "WARN", entry["level"])
+		assert.Equal(t, float64(http.StatusBadRequest), entry["status"])
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+}
+
+func TestRequestHook(t *testing.T) {
+
+	t.Run("Unit Test: Hook is called around each call", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.TypeheadSearchURL).
+			Reply(http.StatusOK).
+			JSON(map[string]any{
+				"transactionDetail":          map[string]string{"transactionID": "test_transactionID"},
+				"candidatesMatchedQuantity":  5,
+				"candidatesReturnedQuantity": 5,
+			})
+
+		hook := &recordingHook{}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithRequestHook(hook),
+		)
+
+		_, err := client.TypeheadSearch(context.Background(), "test_search", "US")
+		assert.NoError(t, err)
+
+		assert.Len(t, hook.started, 1)
+		assert.Equal(t, http.MethodGet, hook.started[0].Method)
+		assert.Equal(t, dnbclient.TypeheadSearchURL, hook.started[0].Endpoint)
+		assert.Equal(t, "test_search", hook.started[0].SearchTerm)
+
+		assert.Len(t, hook.ended, 1)
+		assert.Equal(t, http.StatusOK, hook.ended[0].StatusCode)
+		assert.Equal(t, "test_transactionID", hook.ended[0].TransactionID)
+		assert.Equal(t, 5, hook.ended[0].CandidatesMatchedQuantity)
+		assert.Equal(t, 1, hook.ended[0].Attempts)
+		assert.NoError(t, hook.ended[0].Err)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+
+	t.Run("Unit Test: Middlewares run in order for every endpoint", func(t *testing.T) {
+		transport := &stubTransport{
+			status: http.StatusOK,
+			body:   `{"transactionDetail": {"transactionID": "test_transactionID"}}`,
+		}
+
+		var calls []string
+
+		header := func(name string) dnbclient.Middleware {
+			return func(next dnbclient.Handler) dnbclient.Handler {
+				return func(req *http.Request) ([]byte, error) {
+					calls = append(calls, name)
+					req.Header.Set("X-"+name, "true")
+
+					return next(req)
+				}
+			}
+		}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithTransport(transport),
+			dnbclient.WithAPIToken("test_token"),
+			dnbclient.WithMiddleware(header("First"), header("Second")),
+		)
+
+		_, err := client.CriteriaSearch(context.Background())
+		assert.NoError(t, err)
+
+		_, err = client.TypeheadSearch(context.Background(), "test_search", "US")
+		assert.NoError(t, err)
+
+		_, err = client.SearchContact(context.Background())
+		assert.NoError(t, err)
+
+		_, err = client.GetContactByDUNS(context.Background(), "804735132")
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"First", "Second", "First", "Second", "First", "Second", "First", "Second"}, calls)
+		assert.Len(t, transport.requests, 4)
+
+		for _, req := range transport.requests {
+			assert.Equal(t, "true", req.Header.Get("X-First"))
+			assert.Equal(t, "true", req.Header.Get("X-Second"))
+			assert.Equal(t, "Bearer test_token", req.Header.Get("Authorization"))
+		}
+	})
+
+	t.Run("Unit Test: Middleware can answer without sending the request", func(t *testing.T) {
+		transport := &stubTransport{}
+
+		cache := func(next dnbclient.Handler) dnbclient.Handler {
+			return func(req *http.Request) ([]byte, error) {
+				return []byte(`{"transactionDetail": {"transactionID": "cached_transactionID"}}`), nil
+			}
+		}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithTransport(transport),
+			dnbclient.WithMiddleware(cache),
+		)
+
+		searchResults, err := client.CriteriaSearch(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, "cached_transactionID", searchResults.TransactionDetail.TransactionID)
+		assert.Empty(t, transport.requests)
+	})
+
+	t.Run("Unit Test: Token request does not go through the middlewares", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV3).
+			Post("/v3" + dnbclient.AuthURL).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"access_token": "test_token", "token_type": "Bearer", "expires_in": 3600})
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		var seen []string
+
+		record := func(next dnbclient.Handler) dnbclient.Handler {
+			return func(req *http.Request) ([]byte, error) {
+				seen = append(seen, req.URL.Path)
+
+				return next(req)
+			}
+		}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithTokens("test_key", "test_secret"),
+			dnbclient.WithMiddleware(record),
+		)
+
+		_, err := client.CriteriaSearch(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"/v1" + dnbclient.CriteriaSearchURL}, seen)
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+}
+
+func TestCompanySearchBuilder(t *testing.T) {
+
+	t.Run("Unit Test: Builder produces the request", func(t *testing.T) {
+		request, err := dnbclient.NewCompanySearch().
+			Country("US").
+			Employees(50, 500).
+			Radius(40.7128, -74.0060, 25, "mi").
+			SIC("7372").
+			Page(1, 10).
+			Build()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "US", request.CountryISOAlpha2Code)
+		assert.Equal(t, &dnbclient.EmployeeRange{MinimumValue: 50, MaximumValue: 500}, request.NumberOfEmployees)
+		assert.Equal(t, &dnbclient.LocationRadius{Lat: 40.7128, Lng: -74.0060, Radius: 25, Unit: "mi"}, request.LocationRadius)
+		assert.Equal(t, []string{"7372"}, request.UsSicv4)
+
+		reqBytes, err := json.Marshal(request)
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{
+			"countryISOAlpha2Code": "US",
+			"numberOfEmployees": {"minimumValue": 50, "maximumValue": 500},
+			"locationRadius": {"lat": 40.7128, "lon": -74.006, "radius": 25, "unit": "mi"},
+			"usSicv4": ["7372"],
+			"pageNumber": 1,
+			"pageSize": 10
+		}`, string(reqBytes))
+	})
+
+	t.Run("Unit Test: Builder reports every invalid criteria", func(t *testing.T) {
+		request, err := dnbclient.NewCompanySearch().
+			Country("USA").
+			Employees(500, 50).
+			Radius(100, 0, 0, "yards").
+			Build()
+
+		assert.Nil(t, request)
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
+		assert.ErrorContains(t, err, "countryISOAlpha2Code")
+		assert.ErrorContains(t, err, "numberOfEmployees minimum 500 is greater than maximum 50")
+		assert.ErrorContains(t, err, "locationRadius.lat")
+		assert.ErrorContains(t, err, "locationRadius.radius must be positive")
+		assert.ErrorContains(t, err, "locationRadius.unit")
+	})
+
+	t.Run("Unit Test: Empty search is rejected", func(t *testing.T) {
+		_, err := dnbclient.NewCompanySearch().Build()
+
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
+	})
+}
+
+func TestValidation(t *testing.T) {
+
+	t.Run("Unit Test: Invalid requests are not sent", func(t *testing.T) {
+		transport := &stubTransport{}
+		client, _ := dnbclient.NewClient(dnbclient.WithTransport(transport))
+
+		_, err := client.CriteriaSearch(context.Background(), dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+			DUNS:                 "12345678X",
+			CountryISOAlpha2Code: "USA",
+			PageSize:             dnbclient.CompanySearchMaxPageSize + 1,
+			LocationRadius:       &dnbclient.LocationRadius{Lat: 40.7, Lng: -74, Radius: 25},
+		}))
+
+		assert.ErrorIs(t, err, dnbclient.ErrSearchCriteriaFailed)
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
+
+		var validationError *dnbclient.ValidationError
+		assert.ErrorAs(t, err, &validationError)
+		assert.Equal(t, "duns", validationError.Field)
+
+		assert.ErrorContains(t, err, "countryISOAlpha2Code")
+		assert.ErrorContains(t, err, "pageSize")
+		assert.ErrorContains(t, err, "locationRadius.unit is required")
+
+		_, err = client.TypeheadSearch(context.Background(), "a", "US")
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
+
+		_, err = client.GetContactByDUNS(context.Background(), "80473513X")
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
+
+		assert.Empty(t, transport.requests)
+	})
+
+	t.Run("Unit Test: Pages are checked against the result cap of the endpoint", func(t *testing.T) {
+		transport := &stubTransport{
+			status: http.StatusOK,
+			body:   `{"transactionDetail": {"transactionID": "test_transactionID"}}`,
+		}
+
+		client, _ := dnbclient.NewClient(dnbclient.WithTransport(transport))
+
+		request := dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+			CountryISOAlpha2Code: "US",
+			PageNumber:           41,
+			PageSize:             25,
+		})
+
+		_, err := client.CriteriaSearch(context.Background(), request)
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
+		assert.ErrorContains(t, err, "pageNumber is past the 1000 results")
+		assert.Empty(t, transport.requests)
+
+		_, err = client.CompanyListSearch(context.Background(), request)
+		assert.NoError(t, err)
+		assert.Len(t, transport.requests, 1)
+	})
+
+	t.Run("Unit Test: Validation can be disabled", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.TypeheadSearchURL).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		client, _ := dnbclient.NewClient(dnbclient.WithoutValidation())
+
+		_, err := client.TypeheadSearch(context.Background(), "a", "US")
+
+		assert.NoError(t, err)
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+}
+
+func TestDUNS(t *testing.T) {
+
+	t.Run("Unit Test: DUNS is normalized", func(t *testing.T) {
+		for value, expected := range map[string]dnbclient.DUNS{
+			"15-048-3782":  "150483782",
+			"150483782":    "150483782",
+			"60704780":     "060704780",
+			" 15.048.3782": "150483782",
+		} {
+			duns, err := dnbclient.ParseDUNS(value)
+
+			assert.NoError(t, err)
+			assert.Equal(t, expected, duns)
+		}
+
+		for _, value := range []string{"", "1504837820", "15048378A", "test"} {
+			_, err := dnbclient.ParseDUNS(value)
+
+			assert.ErrorIs(t, err, dnbclient.ErrInvalidDUNS)
+		}
+
+		assert.Equal(t, "06-070-4780", dnbclient.DUNS("60704780").Format())
+	})
+
+	t.Run("Unit Test: DUNS is marshalled normalized", func(t *testing.T) {
+		reqBytes, err := json.Marshal(dnbclient.CompanySearchRequest{DUNSList: []dnbclient.DUNS{"15-048-3782", "60704780"}})
+
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"dunsList": ["150483782", "060704780"]}`, string(reqBytes))
+
+		organization := api_response.Organization{}
+		err = json.Unmarshal([]byte(`{"duns": 60704780}`), &organization)
+
+		assert.NoError(t, err)
+		assert.Equal(t, dnbclient.DUNS("060704780"), organization.Duns)
+	})
+
+	t.Run("Unit Test: Contacts are requested with the normalized DUNS", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.ContactSearchURL).
+			MatchParam("duns", "^150483782$").
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		client, _ := dnbclient.NewClient()
+
+		_, err := client.GetContactByDUNS(context.Background(), "15-048-3782")
+
+		assert.NoError(t, err)
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+}
+
+func TestCriteriaSearch(t *testing.T) {
+
+	client, _ := dnbclient.NewClient(
+		dnbclient.WithCredentials("test_username", "test_password"),
+	)
+
+	t.Run("Unit Test: Successful Criteria Search", func(t *testing.T) {
+
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		searchResults, err := client.CriteriaSearch(
+			context.Background(),
+			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+				SearchTerm:     "test_search_term",
+				TradeStyleName: "test_trade_style_name",
+			}),
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Criteria Search with DUNS and page options", func(t *testing.T) {
+
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			JSON(map[string]any{"duns": "804735132", "pageNumber": 2, "pageSize": 50}).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		searchResults, err := client.CriteriaSearch(
+			context.Background(),
+			dnbclient.WithDUNS("804735132"),
+			dnbclient.WithPage(2, 50),
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Failed Criteria Search", func(t *testing.T) {
+
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			Reply(http.StatusUnauthorized).
+			JSON(map[string]string{"errorMessage": "invalid_request"})
+
+		_, err := client.CriteriaSearch(context.Background())
+
+		assert.Error(t, err)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Criteria Search - functional test", func(t *testing.T) {
+		err := godotenv.Load()
+		if err != nil {
+			fmt.Println("Error loading .env file make sure the file is present and placed in root directory.")
+		}
+
+		if apiToken := os.Getenv("API_TOKEN"); apiToken == "" {
+			t.Skip("Skipping functional test because API_TOKEN is not set")
+			return
+		}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithAPIToken(os.Getenv("API_TOKEN")),
+		)
+
+		searchResults, err := client.CriteriaSearch(
+			context.Background(),
+			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+				TradeStyleName: "Apple",
+			}),
+		)
+
+		fmt.Println("Error: ", err)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, searchResults.TransactionDetail.TransactionID)
+
+	})
+}
+
+func TestCompanyIterator(t *testing.T) {
+
+	client, _ := dnbclient.NewClient()
+
+	t.Run("Unit Test: Criteria search iterator walks all pages", func(t *testing.T) {
+		defer gock.Off()
+
+		mockCompanyPage(dnbclient.CriteriaSearchURL, 1, 2, 5, 2)
+		mockCompanyPage(dnbclient.CriteriaSearchURL, 2, 2, 5, 2)
+		mockCompanyPage(dnbclient.CriteriaSearchURL, 3, 2, 5, 1)
+
+		iterator := client.CriteriaSearchIterator(
+			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+				SearchTerm: "test_search_term",
+				PageSize:   2,
+			}),
+		)
+
+		var results []dnbclient.CompanySearchResult
+		for iterator.Next(context.Background()) {
+			results = append(results, iterator.Result())
+		}
+
+		assert.NoError(t, iterator.Err())
+		assert.Equal(t, 5, iterator.Matched())
+		assert.Len(t, results, 5)
+		assert.Equal(t, dnbclient.DUNS("duns_3_1"), results[4].Organization.Duns)
+		assert.Equal(t, 3, results[4].PageNumber)
+		assert.Equal(t, 5, results[4].DisplaySequence)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Iterator stops at the endpoint cap", func(t *testing.T) {
+		defer gock.Off()
+
+		mockCompanyPage(dnbclient.CriteriaSearchURL, 1, 500, 5000, 500)
+		mockCompanyPage(dnbclient.CriteriaSearchURL, 2, 500, 5000, 500)
+
+		iterator := client.CriteriaSearchIterator(dnbclient.WithPage(1, 500))
+
+		count := 0
+		for iterator.Next(context.Background()) {
+			count++
+		}
+
+		assert.NoError(t, iterator.Err())
+		assert.Equal(t, dnbclient.CriteriaSearchMaxResults, count)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Company list iterator can be stopped early", func(t *testing.T) {
+		defer gock.Off()
+
+		mockCompanyPage(dnbclient.CompanyListURL, 1, 2, 5, 2)
+
+		iterator := client.CompanyListIterator(dnbclient.WithPage(1, 2))
+
+		assert.True(t, iterator.Next(context.Background()))
+		iterator.Stop()
+		assert.False(t, iterator.Next(context.Background()))
+		assert.NoError(t, iterator.Err())
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Iterator stops when the context is cancelled", func(t *testing.T) {
+		defer gock.Off()
+
+		mockCompanyPage(dnbclient.CompanyListURL, 1, 2, 5, 2)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		iterator := client.CompanyListIterator(dnbclient.WithPage(1, 2))
+
+		assert.True(t, iterator.Next(ctx))
+		cancel()
+		assert.False(t, iterator.Next(ctx))
+		assert.ErrorIs(t, iterator.Err(), context.Canceled)
+	})
+
+	t.Run("Unit Test: Iterator returns the request error", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			Reply(http.StatusUnauthorized).
+			JSON(map[string]string{"errorMessage": "invalid_request"})
+
+		iterator := client.CriteriaSearchIterator()
+
+		assert.False(t, iterator.Next(context.Background()))
+		assert.ErrorIs(t, iterator.Err(), dnbclient.ErrSearchCriteriaFailed)
+	})
+}
+
+func TestPartitioner(t *testing.T) {
+
+	client, _ := dnbclient.NewClient()
+
+	t.Run("Unit Test: Over cap search is split by state and deduplicated", func(t *testing.T) {
+		defer gock.Off()
+
+		mockPartition(dnbclient.CriteriaSearchURL, "", 1500, []string{"duns_1"}, []map[string]any{
+			{"name": "CA", "count": 900},
+			{"name": "NY", "count": 600},
+		})
+		mockPartition(dnbclient.CriteriaSearchURL, "CA", 2, []string{"duns_1", "duns_2"}, nil)
+		mockPartition(dnbclient.CriteriaSearchURL, "NY", 2, []string{"duns_2", "duns_3"}, nil)
+
+		partitioner := client.CriteriaSearchPartitioner(dnbclient.PartitionByState)
+		partitioner.PageSize = 2
+
+		result, err := partitioner.Search(context.Background(), dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+			SearchTerm: "test_search_term",
+		}))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1500, result.Matched)
+		assert.Equal(t, 3, result.Requests)
+		assert.Len(t, result.Organizations, 3)
+		assert.Empty(t, result.Uncovered)
+		assert.InDelta(t, 3.0/1500, result.Coverage(), 0.0001)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Search that can not be split is reported as uncovered", func(t *testing.T) {
+		defer gock.Off()
+
+		mockPartition(dnbclient.CompanyListURL, "", 20000, []string{"duns_1"}, nil)
+
+		partitioner := client.CompanyListPartitioner()
+		partitioner.PageSize = 2
+
+		result, err := partitioner.Search(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Organizations, 1)
+		assert.Len(t, result.Uncovered, 1)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Candidates without DUNS are kept and the request page size is used", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			BodyString(`"pageSize":5`).
+			Reply(http.StatusOK).
+			JSON(map[string]any{
+				"candidatesMatchedQuantity": 4,
+				"searchCandidates": []map[string]any{
+					{"organization": map[string]any{"duns": "", "primaryName": "test_name_1"}},
+					{"organization": map[string]any{"duns": "", "primaryName": "test_name_2"}},
+					{"organization": map[string]any{"duns": "804735132"}},
+					{"organization": map[string]any{"duns": "804735132"}},
+				},
+			})
+
+		partitioner := client.CriteriaSearchPartitioner()
+
+		result, err := partitioner.Search(context.Background(), dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+			SearchTerm: "test_search_term",
+			PageSize:   5,
+		}))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Requests)
+		assert.Len(t, result.Organizations, 3)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Partial results are returned with the error", func(t *testing.T) {
+		defer gock.Off()
+
+		mockPartition(dnbclient.CriteriaSearchURL, "", 1500, nil, []map[string]any{
+			{"name": "CA", "count": 900},
+			{"name": "NY", "count": 600},
+		})
+		mockPartition(dnbclient.CriteriaSearchURL, "CA", 1, []string{"duns_1"}, nil)
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			Reply(http.StatusBadRequest).
+			JSON(map[string]any{"error": map[string]string{"errorCode": "10001", "errorMessage": "bad request"}})
+
+		partitioner := client.CriteriaSearchPartitioner(dnbclient.PartitionByState)
+		partitioner.PageSize = 2
+
+		result, err := partitioner.Search(context.Background())
+
+		assert.ErrorIs(t, err, dnbclient.ErrSearchCriteriaFailed)
+		assert.Len(t, result.Organizations, 1)
+	})
+}
+
+func TestTypeheadSearch(t *testing.T) {
+
+	client, _ := dnbclient.NewClient()
+
+	t.Run("Unit Test: Successful Typehead Search", func(t *testing.T) {
+
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.TypeheadSearchURL).
+			MatchParams(map[string]string{
+				"searchTerm":           "test_search",
+				"countryISOAlpha2Code": "US",
+			}).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		searchResults, err := client.TypeheadSearch(
+			context.Background(),
+			"test_search",
+			"US",
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+
+	})
+
+	t.Run("Unit Test: Typehead Search sends every populated field", func(t *testing.T) {
+		transport := &stubTransport{
+			status: http.StatusOK,
+			body:   `{"transactionDetail": {"transactionID": "test_transactionID"}}`,
+		}
+
+		client, _ := dnbclient.NewClient(dnbclient.WithTransport(transport))
+
+		_, err := client.TypeheadSearch(
+			context.Background(),
+			"",
+			"US",
+			dnbclient.WithTypeheadSearchRequest(&dnbclient.TypeheadSearchRequest{
+				SearchTerm:               "test_search",
+				AddressRegion:            "NY",
+				RadiusLat:                40.7128,
+				RadiusLon:                -74.006,
+				RadiusDistance:           25,
+				RadiusUnit:               "mi",
+				CandidateMaximumQuantity: 10,
+				IsDelisted:               true,
+				CustomerReference:        "test_reference",
+			}),
+		)
+
+		assert.NoError(t, err)
+		assert.Len(t, transport.requests, 1)
+		assert.Equal(t, "/v1/search/typeahead", transport.requests[0].URL.Path)
+
+		params := transport.requests[0].URL.Query()
+		assert.Equal(t, "test_search", params.Get("searchTerm"))
+		assert.Equal(t, "US", params.Get("countryISOAlpha2Code"))
+		assert.Equal(t, "NY", params.Get("addressRegion"))
+		assert.Equal(t, "40.7128", params.Get("radiusLat"))
+		assert.Equal(t, "-74.006", params.Get("radiusLon"))
+		assert.Equal(t, "25", params.Get("radiusDistance"))
+		assert.Equal(t, "mi", params.Get("radiusUnit"))
+		assert.Equal(t, "10", params.Get("candidateMaximumQuantity"))
+		assert.Equal(t, "true", params.Get("isDelisted"))
+		assert.Equal(t, "test_reference", params.Get("customerReference"))
+		assert.False(t, params.Has("postalCode"))
+	})
+
+	t.Run("Unit Test: Failed Typehead Search", func(t *testing.T) {
+
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.TypeheadSearchURL).
+			MatchParams(map[string]string{
+				"searchTerm":           "test_search",
+				"countryISOAlpha2Code": "US",
+			}).
+			Reply(http.StatusUnauthorized).
+			JSON(map[string]string{"errorMessage": "invalid_request"})
+
+		_, err := client.TypeheadSearch(
+			context.Background(),
+			"test_search",
+			"US",
+		)
+
+		assert.Error(t, err)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Typehead Search - functional test", func(t *testing.T) {
+		err := godotenv.Load()
+		if err != nil {
+			fmt.Println("Error loading .env file make sure the file is present and placed in root directory.")
+		}
+
+		if apiToken := os.Getenv("API_TOKEN"); apiToken == "" {
+			t.Skip("Skipping functional test because API_TOKEN is not set")
+			return
+		}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithAPIToken(os.Getenv("API_TOKEN")),
+		)
+
+		searchResults, err := client.TypeheadSearch(
+			context.Background(),
+			"Apple",
+			"US",
+		)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, searchResults.TransactionDetail.TransactionID)
+
+	})
+}
+
+func TestTypeaheadSession(t *testing.T) {
+
+	client, _ := dnbclient.NewClient()
+
+	mockTypeahead := func(searchTerm string, names ...string) {
+		candidates := []map[string]any{}
+		for i, name := range names {
+			candidates = append(candidates, map[string]any{
+				"displaySequence": i + 1,
+				"organization":    map[string]any{"primaryName": name},
+			})
+		}
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.TypeheadSearchURL).
+			MatchParam("searchTerm", "^"+searchTerm+"$").
+			Reply(http.StatusOK).
+			JSON(map[string]any{
+				"transactionDetail":          map[string]string{"transactionID": "test_transactionID"},
+				"candidatesMatchedQuantity":  len(candidates),
+				"candidatesReturnedQuantity": len(candidates),
+				"searchCandidates":           candidates,
+			})
+	}
+
+	t.Run("Unit Test: Input is debounced", func(t *testing.T) {
+		defer gock.Off()
+
+		mockTypeahead("appl", "Apple Inc")
+
+		session := client.NewTypeaheadSession(context.Background(), "US", 20*time.Millisecond)
+		defer session.Close()
+
+		session.Input("ap")
+		session.Input("app")
+		session.Input("appl")
+
+		result := <-session.Results()
+
+		assert.NoError(t, result.Err)
+		assert.Equal(t, "appl", result.SearchTerm)
+		assert.Len(t, result.Results.SearchCandidates, 1)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Results of earlier input are reused", func(t *testing.T) {
+		defer gock.Off()
+
+		mockTypeahead("ap", "Apple Inc", "Apex Corp")
+		mockTypeahead("apple", "Apple Inc", "Big Apple Deli")
+
+		session := client.NewTypeaheadSession(context.Background(), "US", time.Millisecond)
+		defer session.Close()
+
+		session.Input("ap")
+		result := <-session.Results()
+
+		assert.NoError(t, result.Err)
+		assert.Len(t, result.Results.SearchCandidates, 2)
+
+		session.Input("apple")
+		result = <-session.Results()
+
+		assert.NoError(t, result.Err)
+		assert.Equal(t, "apple", result.SearchTerm)
+		assert.Len(t, result.Results.SearchCandidates, 2)
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+
+		session.Input("AP")
+		result = <-session.Results()
+
+		assert.NoError(t, result.Err)
+		assert.Equal(t, "AP", result.SearchTerm)
+		assert.Equal(t, "Apex Corp", result.Results.SearchCandidates[1].Organization.PrimaryName)
+	})
+
+	t.Run("Unit Test: Input outside the search term window is not searched", func(t *testing.T) {
+		defer gock.Off()
+
+		session := client.NewTypeaheadSession(context.Background(), "US", time.Millisecond)
+
+		session.Input("a")
+		session.Input(strings.Repeat("a", dnbclient.TypeheadSearchTermMaxLength+1))
+
+		select {
+		case result := <-session.Results():
+			t.Fatalf("unexpected result for %q", result.SearchTerm)
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		session.Close()
+
+		_, ok := <-session.Results()
+		assert.False(t, ok)
+	})
+}
+
+func TestCompanyListSearch(t *testing.T) {
+
+	client, _ := dnbclient.NewClient()
+
+	t.Run("Unit Test: Failed Company List Search", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CompanyListURL).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		searchResults, err := client.CompanyListSearch(
+			context.Background(),
+			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+				SearchTerm:     "test_search_term",
+				TradeStyleName: "test_trade_style_name",
+			}),
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Failed Company List Search", func(t *testing.T) {
+
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CompanyListURL).
+			Reply(http.StatusUnauthorized).
+			JSON(map[string]string{"errorMessage": "invalid_request"})
+
+		_, err := client.CompanyListSearch(
+			context.Background(),
+			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+				SearchTerm:     "test_search_term",
+				TradeStyleName: "test_trade_style_name",
+			}),
+		)
+
+		assert.Error(t, err)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Company List Search - functional test", func(t *testing.T) {
+		err := godotenv.Load()
+		if err != nil {
+			fmt.Println("Error loading .env file make sure the file is present and placed in root directory.")
+		}
+
+		if apiToken := os.Getenv("API_TOKEN"); apiToken == "" {
+			t.Skip("Skipping functional test because API_TOKEN is not set")
+			return
+		}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithAPIToken(os.Getenv("API_TOKEN")),
+		)
+
+		searchResults, err := client.CompanyListSearch(
+			context.Background(),
+			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+				TradeStyleName: "Apple",
+			}),
+		)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, searchResults.TransactionDetail.TransactionID)
+
+	})
+}
+
+func TestSearchContacts(t *testing.T) {
+
+	client, _ := dnbclient.NewClient()
+
+	t.Run("Unit Test: Successful Contact Search", func(t *testing.T) {
+
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.ContactSearchURL).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		searchResults, err := client.SearchContact(
+			context.Background(),
+			dnbclient.WithContactSearchRequest(&dnbclient.ContactSearchRequest{
+				ContactEmail: "test_contact_email",
+			}),
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Standard and premium searches set the view", func(t *testing.T) {
+		defer gock.Off()
+
+		var bodies []map[string]any
+
+		for i := 0; i < 2; i++ {
+			gock.New(dnbclient.BaseURLV1).
+				Post(dnbclient.ContactSearchURL).
+				AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+					var body map[string]any
+					err := json.NewDecoder(req.Body).Decode(&body)
+					bodies = append(bodies, body)
+
+					return err == nil, err
+				}).
+				Reply(http.StatusOK).
+				JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+		}
+
+		_, err := client.SearchContactsStandard(context.Background(), &dnbclient.StandardContactSearchRequest{
+			FamilyName: "test_family_name",
+		})
+		assert.NoError(t, err)
+
+		_, err = client.SearchContactsPremium(context.Background(), &dnbclient.PremiumContactSearchRequest{
+			StandardContactSearchRequest: dnbclient.StandardContactSearchRequest{FamilyName: "test_family_name"},
+			HasDirectDial:                true,
+		})
+		assert.NoError(t, err)
+
+		assert.Len(t, bodies, 2)
+		assert.Equal(t, map[string]any{"familyName": "test_family_name", "view": dnbclient.ContactViewStandard}, bodies[0])
+		assert.Equal(t, map[string]any{"familyName": "test_family_name", "hasDirectDial": true, "view": dnbclient.ContactViewPremium}, bodies[1])
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Standard search rejects premium criteria", func(t *testing.T) {
+		_, err := client.SearchContact(
+			context.Background(),
+			dnbclient.WithContactSearchRequest(&dnbclient.ContactSearchRequest{
+				View:         dnbclient.ContactViewStandard,
+				ContactEmail: "test_contact_email",
+			}),
+		)
+
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
+	})
+
+	t.Run("Unit Test: Premium search falls back to standard when not entitled", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.ContactSearchURL).
+			BodyString(`"view":"premium"`).
+			Reply(http.StatusForbidden).
+			JSON(map[string]any{"error": map[string]string{"errorCode": "00004", "errorMessage": "Not entitled to premium contacts"}})
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.ContactSearchURL).
+			BodyString(`"view":"standard"`).
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		request := &dnbclient.PremiumContactSearchRequest{
+			StandardContactSearchRequest: dnbclient.StandardContactSearchRequest{Duns: "804735132"},
+			ContactEmail:                 "test_contact_email",
+		}
+
+		_, err := client.SearchContactsPremium(context.Background(), request)
+		assert.ErrorIs(t, err, dnbclient.ErrNotEntitled)
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.ContactSearchURL).
+			BodyString(`"view":"premium"`).
+			Reply(http.StatusForbidden).
+			JSON(map[string]any{"error": map[string]string{"errorCode": "00004", "errorMessage": "Not entitled to premium contacts"}})
+
+		searchResults, view, err := client.SearchContactsWithFallback(context.Background(), request)
+
+		assert.NoError(t, err)
+		assert.Equal(t, dnbclient.ContactViewStandard, view)
+		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Premium search does not fall back on other 403 errors", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.ContactSearchURL).
+			BodyString(`"view":"premium"`).
+			Reply(http.StatusForbidden).
+			JSON(map[string]any{"error": map[string]string{"errorCode": "00040", "errorMessage": "Access denied"}})
+
+		request := &dnbclient.PremiumContactSearchRequest{
+			StandardContactSearchRequest: dnbclient.StandardContactSearchRequest{Duns: "804735132"},
+			ContactEmail:                 "test_contact_email",
+		}
+
+		_, view, err := client.SearchContactsWithFallback(context.Background(), request)
+
+		assert.ErrorIs(t, err, dnbclient.ErrContactSearchFailed)
+		assert.NotErrorIs(t, err, dnbclient.ErrNotEntitled)
+		assert.Equal(t, dnbclient.ContactViewPremium, view)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Failed Contact Search", func(t *testing.T) {
+
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.ContactSearchURL).
+			Reply(http.StatusUnauthorized).
+			JSON(map[string]string{"errorMessage": "invalid_request"})
+
+		_, err := client.SearchContact(
+			context.Background(),
+			dnbclient.WithContactSearchRequest(&dnbclient.ContactSearchRequest{
+				ContactEmail: "test_contact_email",
+			}),
+		)
+
+		assert.Error(t, err)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Contact Search - functional test", func(t *testing.T) {
+		err := godotenv.Load()
+		if err != nil {
+			fmt.Println("Error loading .env file make sure the file is present and placed in root directory.")
+		}
+
+		if apiToken := os.Getenv("API_TOKEN"); apiToken == "" {
+			t.Skip("Skipping functional test because API_TOKEN is not set")
+			return
+		}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithAPIToken(os.Getenv("API_TOKEN")),
+		)
+
+		searchResults, err := client.SearchContact(
+			context.Background(),
+			dnbclient.WithContactSearchRequest(&dnbclient.ContactSearchRequest{
+				ContactEmail: "mario.tica@mar-mar.hr",
+			}),
+		)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, searchResults.TransactionDetail.TransactionID)
+	})
+}
+
+func TestContactPager(t *testing.T) {
+
+	client, _ := dnbclient.NewClient()
+
+	t.Run("Unit Test: DUNS pager follows response links", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.ContactSearchURL).
+			MatchParams(map[string]string{"duns": "804735132", "pageNumber": "1", "pageSize": "2"}).
+			Reply(http.StatusOK).
+			JSON(contactPage(1, 2, 3, map[string]string{
+				"first": dnbclient.BaseURLV1 + dnbclient.ContactSearchURL + "?duns=804735132&pageNumber=1&pageSize=2&cursor=test_cursor",
+				"self":  dnbclient.BaseURLV1 + dnbclient.ContactSearchURL + "?duns=804735132&pageNumber=1&pageSize=2&cursor=test_cursor",
+				"last":  dnbclient.BaseURLV1 + dnbclient.ContactSearchURL + "?duns=804735132&pageNumber=2&pageSize=2&cursor=test_cursor",
+			}))
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.ContactSearchURL).
+			MatchParams(map[string]string{"duns": "804735132", "pageNumber": "2", "cursor": "test_cursor"}).
+			Reply(http.StatusOK).
+			JSON(contactPage(2, 1, 3, map[string]string{
+				"self": dnbclient.BaseURLV1 + dnbclient.ContactSearchURL + "?duns=804735132&pageNumber=2&pageSize=2&cursor=test_cursor",
+				"last": dnbclient.BaseURLV1 + dnbclient.ContactSearchURL + "?duns=804735132&pageNumber=2&pageSize=2&cursor=test_cursor",
+			}))
+
+		contacts, err := client.ContactsByDUNSPager("804735132", dnbclient.WithPage(1, 2)).All(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, contacts, 3)
+		assert.Equal(t, "contact_2_1", contacts[2].ID)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Search pager follows the page of response links", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.ContactSearchURL).
+			JSON(map[string]any{"duns": "804735132", "pageNumber": 1, "pageSize": 2}).
+			Reply(http.StatusOK).
+			JSON(contactPage(1, 2, 3, map[string]string{
+				"self": dnbclient.BaseURLV1 + dnbclient.ContactSearchURL + "?pageNumber=1&pageSize=2",
+				"last": dnbclient.BaseURLV1 + dnbclient.ContactSearchURL + "?pageNumber=2&pageSize=2",
+			}))
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.ContactSearchURL).
+			JSON(map[string]any{"duns": "804735132", "pageNumber": 2, "pageSize": 2}).
+			Reply(http.StatusOK).
+			JSON(contactPage(2, 1, 3, map[string]string{
+				"self": dnbclient.BaseURLV1 + dnbclient.ContactSearchURL + "?pageNumber=2&pageSize=2",
+				"last": dnbclient.BaseURLV1 + dnbclient.ContactSearchURL + "?pageNumber=2&pageSize=2",
+			}))
+
+		pager := client.SearchContactPager(
+			dnbclient.WithContactSearchRequest(&dnbclient.ContactSearchRequest{
+				Duns: "804735132",
+			}),
+			dnbclient.WithPage(1, 2),
+		)
+
+		contacts, err := pager.All(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, contacts, 3)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Search pager falls back to page numbers and keeps partial results", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.ContactSearchURL).
+			JSON(map[string]any{"duns": "804735132", "pageNumber": 1, "pageSize": 2}).
+			Reply(http.StatusOK).
+			JSON(contactPage(1, 2, 10, nil))
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.ContactSearchURL).
+			JSON(map[string]any{"duns": "804735132", "pageNumber": 2, "pageSize": 2}).
+			Reply(http.StatusInternalServerError).
+			JSON(map[string]string{"errorMessage": "internal_error"})
+
+		pager := client.SearchContactPager(
+			dnbclient.WithContactSearchRequest(&dnbclient.ContactSearchRequest{
+				Duns: "804735132",
+			}),
+			dnbclient.WithPage(1, 2),
+		)
+
+		contacts, err := pager.All(context.Background())
+
+		assert.ErrorIs(t, err, dnbclient.ErrContactSearchFailed)
+		assert.Len(t, contacts, 2)
+		assert.Equal(t, 10, pager.Matched())
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Links to other hosts are not followed", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.ContactSearchURL).
+			Reply(http.StatusOK).
+			JSON(contactPage(1, 2, 10, map[string]string{
+				"self": "https://example.com/v1/search/contact?pageNumber=1",
+				"last": "https://example.com/v1/search/contact?pageNumber=5",
+			}))
+
+		contacts, err := client.ContactsByDUNSPager("804735132", dnbclient.WithPage(1, 2)).All(context.Background())
+
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidLink)
+		assert.Len(t, contacts, 2)
+	})
+
+	t.Run("Unit Test: Plain http links are not followed", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.ContactSearchURL).
+			Reply(http.StatusOK).
+			JSON(contactPage(1, 2, 10, map[string]string{
+				"self": "http://plus.dnb.com/v1/search/contact?pageNumber=1",
+				"last": "http://plus.dnb.com/v1/search/contact?pageNumber=5",
+			}))
+
+		contacts, err := client.ContactsByDUNSPager("804735132", dnbclient.WithPage(1, 2)).All(context.Background())
+
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidLink)
+		assert.Len(t, contacts, 2)
+	})
+}
+
+func TestGetDataBlocks(t *testing.T) {
 
+	t.Run("Unit Test: Successful Get Data Blocks", func(t *testing.T) {
 		defer gock.Off()
 
 		gock.New(dnbclient.BaseURLV1).
-			Post(dnbclient.CriteriaSearchURL).
+			Get(dnbclient.DataBlocksURL+"/150483782").
+			MatchParam("blockIDs", "^companyinfo_L2_v1,hierarchyconnections_L1_v2,esginsight_L3_v1$").
 			Reply(http.StatusOK).
-			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+			JSON(map[string]any{
+				"transactionDetail": map[string]string{"transactionID": "test_transactionID"},
+				"blockStatus": []map[string]string{
+					{"blockID": "companyinfo_L2_v1", "status": "ok"},
+				},
+				"organization": map[string]any{
+					"duns":              "150483782",
+					"primaryName":       "test_primary_name",
+					"numberOfEmployees": []map[string]any{{"value": 120}},
+					"corporateLinkage": map[string]any{
+						"hierarchyLevel": 2,
+						"parent":         map[string]any{"duns": "804735132"},
+					},
+					"esgRanking": map[string]any{"score": 3},
+				},
+			})
+
+		hook := &recordingHook{}
+		client, _ := dnbclient.NewClient(dnbclient.WithRequestHook(hook))
+
+		block, err := dnbclient.ParseDataBlock("hierarchyconnections_L1_v2")
+		assert.NoError(t, err)
 
-		searchResults, err := client.CriteriaSearch(
+		dataBlocks, err := client.GetDataBlocks(
 			context.Background(),
-			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
-				SearchTerm:     "test_search_term",
-				TradeStyleName: "test_trade_style_name",
-			}),
+			"15-048-3782",
+			dnbclient.Block(dnbclient.CompanyInfoBlock, 2),
+			block,
+			dnbclient.Block("esginsight", 3),
 		)
 
 		assert.NoError(t, err)
-		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
-
-		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
-	})
-
-	t.Run("Unit Test: Failed Criteria Search", func(t *testing.T) {
-
-		defer gock.Off()
+		assert.Equal(t, "test_transactionID", dataBlocks.TransactionDetail.TransactionID)
 
-		gock.New(dnbclient.BaseURLV1).
-			Post(dnbclient.CriteriaSearchURL).
-			Reply(http.StatusUnauthorized).
-			JSON(map[string]string{"errorMessage": "invalid_request"})
+		organization := dataBlocks.Organization
+		assert.Equal(t, dnbclient.DUNS("150483782"), organization.Duns)
+		assert.Equal(t, "test_primary_name", organization.PrimaryName)
+		assert.Equal(t, 120, organization.NumberOfEmployees[0].Value)
+		assert.Equal(t, 2, organization.CorporateLinkage.HierarchyLevel)
+		assert.Equal(t, dnbclient.DUNS("804735132"), organization.CorporateLinkage.Parent.Duns)
+		assert.Len(t, organization.Unknown, 1)
+		assert.JSONEq(t, `{"score": 3}`, string(organization.Unknown["esgRanking"]))
 
-		_, err := client.CriteriaSearch(context.Background())
-
-		assert.Error(t, err)
+		assert.Len(t, hook.started, 1)
+		assert.Equal(t, dnbclient.DataBlocksURL, hook.started[0].Endpoint)
 
 		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
 	})
 
-	t.Run("Criteria Search - functional test", func(t *testing.T) {
-		err := godotenv.Load()
-		if err != nil {
-			fmt.Println("Error loading .env file make sure the file is present and placed in root directory.")
-		}
-
-		if apiToken := os.Getenv("API_TOKEN"); apiToken == "" {
-			t.Skip("Skipping functional test because API_TOKEN is not set")
-			return
-		}
+	t.Run("Unit Test: Invalid data blocks request is not sent", func(t *testing.T) {
+		transport := &stubTransport{}
+		client, _ := dnbclient.NewClient(dnbclient.WithTransport(transport))
 
-		searchResults, err := client.CriteriaSearch(
-			context.Background(),
-			dnbclient.WithAPIToken(os.Getenv("API_TOKEN")),
-			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
-				TradeStyleName: "Apple",
-			}),
-		)
+		_, err := client.GetDataBlocks(context.Background(), "804735132")
+		assert.ErrorIs(t, err, dnbclient.ErrGetDataBlocksFailed)
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
 
-		fmt.Println("Error: ", err)
+		_, err = client.GetDataBlocks(context.Background(), "", dnbclient.Block(dnbclient.CompanyInfoBlock, 2))
+		assert.ErrorIs(t, err, dnbclient.ErrGetDataBlocksFailed)
+		assert.ErrorContains(t, err, "duns is required")
 
-		assert.NoError(t, err)
-		assert.NotEmpty(t, searchResults.TransactionDetail.TransactionID)
+		_, err = dnbclient.ParseDataBlock("companyinfo_2")
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
 
+		assert.Empty(t, transport.requests)
 	})
 }
 
-func TestTypeheadSearch(t *testing.T) {
-
-	client, _ := dnbclient.NewClient()
-
-	t.Run("Unit Test: Successful Typehead Search", func(t *testing.T) {
+func TestCleanseMatch(t *testing.T) {
 
+	t.Run("Unit Test: Successful Cleanse Match", func(t *testing.T) {
 		defer gock.Off()
 
 		gock.New(dnbclient.BaseURLV1).
-			Get(dnbclient.TypeheadSearchURL).
-			MatchParams(map[string]string{
-				"searchTerm":           "test_search",
-				"countryISOAlpha2Code": "test_country",
-			}).
+			Get(dnbclient.CleanseMatchURL).
+			MatchParam("name", "^test_primary_name$").
+			MatchParam("telephoneNumber", "^5551234$").
+			MatchParam("countryISOAlpha2Code", "^US$").
+			MatchParam("url", "^example.com$").
 			Reply(http.StatusOK).
-			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
-
-		searchResults, err := client.TypeheadSearch(
-			context.Background(),
-			"test_search",
-			"test_country",
-		)
+			JSON(map[string]any{
+				"transactionDetail":          map[string]string{"transactionID": "test_transactionID"},
+				"candidatesMatchedQuantity":  2,
+				"candidatesReturnedQuantity": 2,
+				"matchCandidates": []map[string]any{
+					{
+						"displaySequence": 1,
+						"organization":    map[string]any{"duns": "804735132", "primaryName": "test_primary_name"},
+						"matchQualityInformation": map[string]any{
+							"confidenceCode":   8,
+							"matchGrade":       "AAZZAZZZFAZ",
+							"matchDataProfile": "0000000000989800000000009898",
+							"matchGradeComponents": []map[string]any{
+								{"componentType": "Name", "componentRating": "A", "componentScore": 100},
+							},
+						},
+					},
+					{
+						"displaySequence":         2,
+						"organization":            map[string]any{"duns": "150483782"},
+						"matchQualityInformation": map[string]any{"confidenceCode": 5},
+					},
+				},
+			})
+
+		client, _ := dnbclient.NewClient()
+
+		request := dnbclient.NewCleanseMatchRequest(&dnbclient.CompanySearchRequest{
+			PrimaryName:          "test_primary_name",
+			TelephoneNumber:      "5551234",
+			CountryISOAlpha2Code: "US",
+			Domain:               "example.com",
+		})
+
+		match, err := client.CleanseMatch(context.Background(), request)
 
 		assert.NoError(t, err)
-		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+		assert.Equal(t, "test_transactionID", match.TransactionDetail.TransactionID)
+		assert.Len(t, match.MatchCandidates, 2)
 
-		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+		candidate := match.MatchCandidates[0]
+		assert.Equal(t, dnbclient.DUNS("804735132"), candidate.Organization.Duns)
+		assert.Equal(t, 8, candidate.MatchQualityInformation.ConfidenceCode)
+		assert.Equal(t, "AAZZAZZZFAZ", candidate.MatchQualityInformation.MatchGrade)
+		assert.Equal(t, "A", candidate.MatchQualityInformation.MatchGradeComponents[0].ComponentRating)
 
-	})
+		accepted, ok := match.Accept(8)
+		assert.True(t, ok)
+		assert.Equal(t, dnbclient.DUNS("804735132"), accepted.Organization.Duns)
 
-	t.Run("Unit Test: Failed Typehead Search", func(t *testing.T) {
+		_, ok = match.Accept(9)
+		assert.False(t, ok)
 
-		defer gock.Off()
+		assert.Len(t, match.CandidatesAbove(5), 2)
+		assert.Len(t, match.CandidatesAbove(6), 1)
 
-		gock.New(dnbclient.BaseURLV1).
-			Get(dnbclient.TypeheadSearchURL).
-			MatchParams(map[string]string{
-				"searchTerm":           "test_search",
-				"countryISOAlpha2Code": "test_country",
-			}).
-			Reply(http.StatusUnauthorized).
-			JSON(map[string]string{"errorMessage": "invalid_request"})
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
 
-		_, err := client.TypeheadSearch(
-			context.Background(),
-			"test_search",
-			"test_country",
-		)
+	t.Run("Unit Test: Invalid cleanse match request is not sent", func(t *testing.T) {
+		transport := &stubTransport{}
+		client, _ := dnbclient.NewClient(dnbclient.WithTransport(transport))
 
-		assert.Error(t, err)
+		_, err := client.CleanseMatch(context.Background(), &dnbclient.CleanseMatchRequest{
+			Name:                     "test_primary_name",
+			CandidateMaximumQuantity: 30,
+		})
 
-		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+		assert.ErrorIs(t, err, dnbclient.ErrCleanseMatchFailed)
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
+		assert.ErrorContains(t, err, "countryISOAlpha2Code is required")
+		assert.ErrorContains(t, err, "candidateMaximumQuantity must be between 1 and 25")
+
+		assert.Empty(t, transport.requests)
 	})
+}
 
-	t.Run("Typehead Search - functional test", func(t *testing.T) {
-		err := godotenv.Load()
-		if err != nil {
-			fmt.Println("Error loading .env file make sure the file is present and placed in root directory.")
+func TestExtendedMatch(t *testing.T) {
+
+	t.Run("Unit Test: Extended Match sends the flags as exclusion criteria", func(t *testing.T) {
+		transport := &stubTransport{
+			status: http.StatusOK,
+			body: `{
+				"transactionDetail": {"transactionID": "test_transactionID"},
+				"matchCandidates": [{
+					"displaySequence": 1,
+					"organization": {"duns": "804735132"},
+					"matchQualityInformation": {"confidenceCode": 9}
+				}]
+			}`,
 		}
 
-		if apiToken := os.Getenv("API_TOKEN"); apiToken == "" {
-			t.Skip("Skipping functional test because API_TOKEN is not set")
-			return
-		}
+		client, _ := dnbclient.NewClient(dnbclient.WithTransport(transport))
 
-		searchResults, err := client.TypeheadSearch(
-			context.Background(),
-			"Apple",
-			"US",
-			dnbclient.WithAPIToken(os.Getenv("API_TOKEN")),
-		)
+		match, err := client.ExtendedMatch(context.Background(), &dnbclient.ExtendedMatchRequest{
+			CleanseMatchRequest: dnbclient.CleanseMatchRequest{
+				Name:                     "test_primary_name",
+				CountryISOAlpha2Code:     "US",
+				CandidateMaximumQuantity: 5,
+			},
+			ExcludeNonHeadQuarters: true,
+			ExcludeOutOfBusiness:   true,
+		})
 
 		assert.NoError(t, err)
-		assert.NotEmpty(t, searchResults.TransactionDetail.TransactionID)
+		assert.Len(t, transport.requests, 1)
+		assert.Equal(t, "/v1"+dnbclient.ExtendedMatchURL, transport.requests[0].URL.Path)
+
+		assert.Equal(
+			t,
+			"candidateMaximumQuantity=5&countryISOAlpha2Code=US"+
+				"&exclusionCriteria=ExcludeNonHeadQuarters&exclusionCriteria=ExcludeOutofBusiness"+
+				"&name=test_primary_name",
+			transport.requests[0].URL.RawQuery,
+		)
 
+		candidate, ok := match.Accept(9)
+		assert.True(t, ok)
+		assert.Equal(t, dnbclient.DUNS("804735132"), candidate.Organization.Duns)
 	})
-}
 
-func TestCompanyListSearch(t *testing.T) {
+	t.Run("Unit Test: Invalid extended match request is not sent", func(t *testing.T) {
+		transport := &stubTransport{}
+		client, _ := dnbclient.NewClient(dnbclient.WithTransport(transport))
+
+		_, err := client.ExtendedMatch(context.Background(), &dnbclient.ExtendedMatchRequest{
+			CleanseMatchRequest: dnbclient.CleanseMatchRequest{
+				Name:                               "test_primary_name",
+				CountryISOAlpha2Code:               "US",
+				ConfidenceLowerLevelThresholdValue: 11,
+			},
+		})
+
+		assert.ErrorIs(t, err, dnbclient.ErrExtendedMatchFailed)
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
+		assert.Empty(t, transport.requests)
+	})
+}
 
-	client, _ := dnbclient.NewClient()
+func TestGetFamilyTree(t *testing.T) {
 
-	t.Run("Unit Test: Failed Company List Search", func(t *testing.T) {
+	t.Run("Unit Test: Successful Get Family Tree across pages", func(t *testing.T) {
 		defer gock.Off()
 
+		member := func(duns string, level int, role int, link string, linkDUNS string) map[string]any {
+			linkage := map[string]any{
+				"hierarchyLevel":        level,
+				"familytreeRolesPlayed": []map[string]any{{"dnbCode": role}},
+			}
+
+			if link != "" {
+				linkage[link] = map[string]any{"duns": linkDUNS}
+			}
+
+			return map[string]any{"duns": duns, "primaryName": "test_" + duns, "corporateLinkage": linkage}
+		}
+
 		gock.New(dnbclient.BaseURLV1).
-			Post(dnbclient.CompanyListURL).
+			Get(dnbclient.FamilyTreeURL+"/100000002").
+			MatchParam("page[number]", "^1$").
+			MatchParam("page[size]", "^1000$").
 			Reply(http.StatusOK).
-			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+			JSON(map[string]any{
+				"globalUltimateDuns":                   "100000001",
+				"globalUltimateFamilyTreeMembersCount": 5,
+				"links":                                map[string]string{"next": dnbclient.BaseURLV1 + dnbclient.FamilyTreeURL + "/100000002?page[number]=2&page[size]=1000"},
+				"familyTreeMembers": []map[string]any{
+					member("100000002", 2, dnbclient.FamilyTreeRoleSubsidiary, "parent", "100000001"),
+					member("100000001", 1, dnbclient.FamilyTreeRoleGlobalUltimate, "", ""),
+					member("100000003", 2, dnbclient.FamilyTreeRoleBranch, "headQuarter", "100000001"),
+				},
+			})
 
-		searchResults, err := client.CompanyListSearch(
-			context.Background(),
-			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
-				SearchTerm:     "test_search_term",
-				TradeStyleName: "test_trade_style_name",
-			}),
-		)
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.FamilyTreeURL+"/100000002").
+			MatchParam("page[number]", "^2$").
+			Reply(http.StatusOK).
+			JSON(map[string]any{
+				"globalUltimateDuns":                   "100000001",
+				"globalUltimateFamilyTreeMembersCount": 5,
+				"familyTreeMembers": []map[string]any{
+					member("100000004", 3, dnbclient.FamilyTreeRoleSubsidiary, "parent", "100000002"),
+					member("100000005", 3, dnbclient.FamilyTreeRoleSubsidiary, "parent", "999999999"),
+				},
+			})
+
+		hook := &recordingHook{}
+		client, _ := dnbclient.NewClient(dnbclient.WithRequestHook(hook))
+
+		tree, err := client.GetFamilyTree(context.Background(), "10-000-0002")
 
 		assert.NoError(t, err)
-		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+		assert.Equal(t, 5, tree.Len())
+		assert.Equal(t, 5, tree.MembersCount)
+
+		root := tree.Root
+		assert.Equal(t, dnbclient.DUNS("100000001"), root.DUNS())
+		assert.True(t, root.IsGlobalUltimate())
+		assert.Equal(t, 1, root.HierarchyLevel())
+		assert.Len(t, root.Children, 2)
+		assert.Equal(t, 4, root.SubtreeSize())
+
+		subsidiary, ok := tree.Find("100000002")
+		assert.True(t, ok)
+		assert.True(t, subsidiary.IsSubsidiary())
+		assert.False(t, subsidiary.IsBranch())
+		assert.Equal(t, root, subsidiary.Parent)
+		assert.Equal(t, 2, subsidiary.SubtreeSize())
+
+		branch, ok := root.Find("100000003")
+		assert.True(t, ok)
+		assert.True(t, branch.IsBranch())
+		assert.False(t, branch.IsSubsidiary())
+
+		grandchild, ok := subsidiary.Find("100000004")
+		assert.True(t, ok)
+		assert.Equal(t, 3, grandchild.HierarchyLevel())
+		assert.Equal(t, root, grandchild.Ultimate())
+
+		_, ok = subsidiary.Find("100000003")
+		assert.False(t, ok)
+
+		assert.Len(t, tree.Orphans, 1)
+		assert.Equal(t, dnbclient.DUNS("100000005"), tree.Orphans[0].DUNS())
+
+		visited := []dnbclient.DUNS{}
+		tree.Walk(func(node *dnbclient.FamilyTreeNode) bool {
+			visited = append(visited, node.DUNS())
+
+			return node.DUNS() != "100000004"
+		})
+		assert.Equal(t, []dnbclient.DUNS{"100000001", "100000002", "100000004"}, visited)
+
+		assert.Len(t, hook.started, 2)
+		assert.Equal(t, dnbclient.FamilyTreeURL, hook.started[1].Endpoint)
 
 		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
 	})
 
-	t.Run("Unit Test: Failed Company List Search", func(t *testing.T) {
-
+	t.Run("Unit Test: Family tree without members count follows the next links", func(t *testing.T) {
 		defer gock.Off()
 
-		gock.New(dnbclient.BaseURLV1).
-			Post(dnbclient.CompanyListURL).
-			Reply(http.StatusUnauthorized).
-			JSON(map[string]string{"errorMessage": "invalid_request"})
-
-		_, err := client.CompanyListSearch(
-			context.Background(),
-			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
-				SearchTerm:     "test_search_term",
-				TradeStyleName: "test_trade_style_name",
-			}),
-		)
+		member := func(duns string, parentDUNS string) map[string]any {
+			linkage := map[string]any{}
+			if parentDUNS != "" {
+				linkage["parent"] = map[string]any{"duns": parentDUNS}
+			}
 
-		assert.Error(t, err)
+			return map[string]any{"duns": duns, "corporateLinkage": linkage}
+		}
 
-		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
-	})
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.FamilyTreeURL+"/100000001").
+			MatchParam("page[number]", "^1$").
+			Reply(http.StatusOK).
+			JSON(map[string]any{
+				"globalUltimateDuns": "100000001",
+				"links":              map[string]string{"next": "/v1" + dnbclient.FamilyTreeURL + "/100000001?page[number]=2&page[size]=1000"},
+				"familyTreeMembers": []map[string]any{
+					member("100000001", ""),
+					member("100000002", "100000001"),
+				},
+			})
 
-	t.Run("Company List Search - functional test", func(t *testing.T) {
-		err := godotenv.Load()
-		if err != nil {
-			fmt.Println("Error loading .env file make sure the file is present and placed in root directory.")
-		}
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.FamilyTreeURL+"/100000001").
+			MatchParam("page[number]", "^2$").
+			Reply(http.StatusOK).
+			JSON(map[string]any{
+				"globalUltimateDuns": "100000001",
+				"familyTreeMembers":  []map[string]any{member("100000003", "100000002")},
+			})
 
-		if apiToken := os.Getenv("API_TOKEN"); apiToken == "" {
-			t.Skip("Skipping functional test because API_TOKEN is not set")
-			return
-		}
+		client, _ := dnbclient.NewClient()
 
-		searchResults, err := client.CompanyListSearch(
-			context.Background(),
-			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
-				TradeStyleName: "Apple",
-			}),
-			dnbclient.WithAPIToken(os.Getenv("API_TOKEN")),
-		)
+		tree, err := client.GetFamilyTree(context.Background(), "100000001")
 
 		assert.NoError(t, err)
-		assert.NotEmpty(t, searchResults.TransactionDetail.TransactionID)
+		assert.Equal(t, 3, tree.Len())
+		assert.Equal(t, 0, tree.MembersCount)
+		assert.Equal(t, 3, tree.Root.SubtreeSize())
 
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
 	})
-}
 
-func TestSearchContacts(t *testing.T) {
+	t.Run("Unit Test: Invalid family tree request is not sent", func(t *testing.T) {
+		transport := &stubTransport{}
+		client, _ := dnbclient.NewClient(dnbclient.WithTransport(transport))
 
-	client, _ := dnbclient.NewClient()
+		tree, err := client.GetFamilyTree(context.Background(), "12345678X")
 
-	t.Run("Unit Test: Successful Contact Search", func(t *testing.T) {
+		assert.ErrorIs(t, err, dnbclient.ErrGetFamilyTreeFailed)
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
+		assert.Equal(t, 0, tree.Len())
+		assert.Empty(t, transport.requests)
+	})
+}
 
+func TestGetBeneficialOwnership(t *testing.T) {
+
+	t.Run("Unit Test: Successful Get Beneficial Ownership", func(t *testing.T) {
 		defer gock.Off()
 
 		gock.New(dnbclient.BaseURLV1).
-			Post(dnbclient.ContactSearchURL).
+			Get(dnbclient.BeneficialOwnershipURL).
+			MatchParam("duns", "^804735132$").
+			MatchParam("productId", "^cmpbol$").
+			MatchParam("versionId", "^v1$").
+			MatchParam("ownershipPercentage", "^2.5$").
 			Reply(http.StatusOK).
-			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
-
-		searchResults, err := client.SearchContact(
-			context.Background(),
-			dnbclient.WithContactSearchRequest(&dnbclient.ContactSearchRequest{
-				ContactEmail: "test_contact_email",
-			}),
-		)
+			JSON(map[string]any{
+				"transactionDetail": map[string]string{"transactionID": "test_transactionID"},
+				"organization": map[string]any{
+					"duns":        "804735132",
+					"primaryName": "test_primary_name",
+					"beneficialOwnership": map[string]any{
+						"beneficialOwners": []map[string]any{
+							{
+								"memberID":                      4,
+								"name":                          "test_person_direct",
+								"beneficiaryType":               map[string]any{"dnbCode": api_response.BeneficiaryTypeIndividual},
+								"nationality":                   map[string]any{"isoAlpha2Code": "GB"},
+								"degreeOfSeparation":            1,
+								"beneficialOwnershipPercentage": 50,
+							},
+							{
+								"memberID":                  3,
+								"duns":                      "150483782",
+								"name":                      "test_holding",
+								"beneficiaryType":           map[string]any{"dnbCode": api_response.BeneficiaryTypeBusiness},
+								"address":                   map[string]any{"addressCountry": map[string]any{"isoAlpha2Code": "NL"}},
+								"degreeOfSeparation":        1,
+								"directOwnershipPercentage": 50,
+							},
+							{
+								"memberID":           2,
+								"name":               "test_person_indirect",
+								"beneficiaryType":    map[string]any{"dnbCode": api_response.BeneficiaryTypeIndividual},
+								"degreeOfSeparation": 2,
+							},
+						},
+						"relationships": []map[string]any{
+							{"sourceMemberID": 4, "targetMemberID": 1, "sharePercentage": 50},
+							{"sourceMemberID": 3, "targetMemberID": 1, "sharePercentage": 50},
+							{"sourceMemberID": 2, "targetMemberID": 3, "sharePercentage": 60},
+						},
+					},
+				},
+			})
+
+		client, _ := dnbclient.NewClient()
+
+		ownership, err := client.GetBeneficialOwnership(context.Background(), "804735132", 2.5)
 
 		assert.NoError(t, err)
-		assert.Equal(t, "test_transactionID", searchResults.TransactionDetail.TransactionID)
+		assert.Equal(t, dnbclient.DUNS("804735132"), ownership.Organization.Duns)
 
-		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
-	})
+		graph := ownership.Organization.BeneficialOwnership
+		assert.Len(t, graph.BeneficialOwners, 3)
 
-	t.Run("Unit Test: Failed Contact Search", func(t *testing.T) {
+		person := graph.BeneficialOwners[0]
+		assert.True(t, person.IsPerson())
+		assert.Equal(t, "GB", person.Country())
+		assert.Equal(t, 1, person.DegreeOfSeparation)
 
-		defer gock.Off()
+		holding := graph.BeneficialOwners[1]
+		assert.True(t, holding.IsOrganization())
+		assert.Equal(t, "NL", holding.Country())
+		assert.Equal(t, float64(50), holding.Percentage())
 
-		gock.New(dnbclient.BaseURLV1).
-			Post(dnbclient.ContactSearchURL).
-			Reply(http.StatusUnauthorized).
-			JSON(map[string]string{"errorMessage": "invalid_request"})
+		owners := graph.Flatten(25)
+		assert.Len(t, owners, 2)
+		assert.Equal(t, "test_person_direct", owners[0].Name)
+		assert.Equal(t, "test_person_indirect", owners[1].Name)
+		assert.InDelta(t, 30, owners[1].Percentage(), 0.001)
+		assert.Equal(t, 2, owners[1].DegreeOfSeparation)
 
-		_, err := client.SearchContact(
-			context.Background(),
-			dnbclient.WithContactSearchRequest(&dnbclient.ContactSearchRequest{
-				ContactEmail: "test_contact_email",
-			}),
-		)
+		owners = graph.Flatten(40)
+		assert.Len(t, owners, 1)
+		assert.Equal(t, "test_person_direct", owners[0].Name)
 
-		assert.Error(t, err)
+		graph.BeneficialOwners[1].IsBeneficiary = true
+
+		owners = graph.Flatten(40)
+		assert.Len(t, owners, 2)
+		assert.Equal(t, "test_holding", owners[1].Name)
 
 		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
 	})
 
-	t.Run("Contact Search - functional test", func(t *testing.T) {
-		err := godotenv.Load()
-		if err != nil {
-			fmt.Println("Error loading .env file make sure the file is present and placed in root directory.")
-		}
-
-		if apiToken := os.Getenv("API_TOKEN"); apiToken == "" {
-			t.Skip("Skipping functional test because API_TOKEN is not set")
-			return
-		}
+	t.Run("Unit Test: Invalid beneficial ownership request is not sent", func(t *testing.T) {
+		transport := &stubTransport{}
+		client, _ := dnbclient.NewClient(dnbclient.WithTransport(transport))
 
-		searchResults, err := client.SearchContact(
-			context.Background(),
-			dnbclient.WithContactSearchRequest(&dnbclient.ContactSearchRequest{
-				ContactEmail: "mario.tica@mar-mar.hr",
-			}),
-			dnbclient.WithAPIToken(os.Getenv("API_TOKEN")),
-		)
+		_, err := client.GetBeneficialOwnership(context.Background(), "804735132", 120)
 
-		assert.NoError(t, err)
-		assert.NotEmpty(t, searchResults.TransactionDetail.TransactionID)
+		assert.ErrorIs(t, err, dnbclient.ErrGetOwnershipFailed)
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
+		assert.Empty(t, transport.requests)
 	})
 }
 
@@ -383,11 +2411,11 @@ func TestGetContactByDUNS(t *testing.T) {
 
 		gock.New(dnbclient.BaseURLV1).
 			Get(dnbclient.ContactSearchURL).
-			MatchParam("duns", "test_duns").
+			MatchParam("duns", "804735132").
 			Reply(http.StatusOK).
 			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
 
-		searchResults, err := client.GetContactByDUNS(context.Background(), "test_duns")
+		searchResults, err := client.GetContactByDUNS(context.Background(), "804735132")
 
 		assert.NoError(t, err)
 
@@ -400,11 +2428,11 @@ func TestGetContactByDUNS(t *testing.T) {
 
 		gock.New(dnbclient.BaseURLV1).
 			Get(dnbclient.ContactSearchURL).
-			MatchParam("duns", "test_duns").
+			MatchParam("duns", "804735132").
 			Reply(http.StatusUnauthorized).
 			JSON(map[string]string{"errorMessage": "invalid_request"})
 
-		_, err := client.GetContactByDUNS(context.Background(), "test_duns")
+		_, err := client.GetContactByDUNS(context.Background(), "804735132")
 
 		assert.Error(t, err)
 
@@ -412,6 +2440,43 @@ func TestGetContactByDUNS(t *testing.T) {
 
 	})
 
+	t.Run("Unit Test: Get Contact By ID and Email apply the page", func(t *testing.T) {
+		transport := &stubTransport{
+			status: http.StatusOK,
+			body:   `{"transactionDetail": {"transactionID": "test_transactionID"}}`,
+		}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithTransport(transport),
+			dnbclient.WithAPIToken("test_token"),
+		)
+
+		_, err := client.GetContactByID(context.Background(), "test_contact_id", dnbclient.WithPage(2, 10))
+		assert.NoError(t, err)
+
+		_, err = client.GetContactByEmail(context.Background(), "test_email", dnbclient.WithPage(3, 20))
+		assert.NoError(t, err)
+
+		assert.Len(t, transport.requests, 2)
+		assert.Equal(t, "contactID=test_contact_id&pageNumber=2&pageSize=10", transport.requests[0].URL.RawQuery)
+		assert.Equal(t, "contactEmail=test_email&pageNumber=3&pageSize=20", transport.requests[1].URL.RawQuery)
+	})
+
+	t.Run("Unit Test: Get Contact By Email rejects an invalid page", func(t *testing.T) {
+		transport := &stubTransport{status: http.StatusOK, body: `{}`}
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithTransport(transport),
+			dnbclient.WithAPIToken("test_token"),
+		)
+
+		_, err := client.GetContactByEmail(context.Background(), "test_email", dnbclient.WithPage(1, dnbclient.ContactSearchMaxPageSize+1))
+
+		assert.ErrorIs(t, err, dnbclient.ErrGetContactsFailed)
+		assert.ErrorIs(t, err, dnbclient.ErrInvalidRequest)
+		assert.Empty(t, transport.requests)
+	})
+
 	t.Run("Get Contact By DUNS - functional test", func(t *testing.T) {
 
 		err := godotenv.Load()
@@ -424,7 +2489,11 @@ func TestGetContactByDUNS(t *testing.T) {
 			return
 		}
 
-		searchResults, err := client.GetContactByDUNS(context.Background(), "000001591", dnbclient.WithAPIToken(os.Getenv("API_TOKEN")))
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithAPIToken(os.Getenv("API_TOKEN")),
+		)
+
+		searchResults, err := client.GetContactByDUNS(context.Background(), "000001591")
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, searchResults.TransactionDetail.TransactionID)
@@ -432,6 +2501,122 @@ func TestGetContactByDUNS(t *testing.T) {
 
 }
 
+func mockCompanyPage(endpoint string, pageNumber int, pageSize int, matched int, returned int) {
+	candidates := []map[string]any{}
+	for i := 0; i < returned; i++ {
+		candidates = append(candidates, map[string]any{
+			"displaySequence": (pageNumber-1)*pageSize + i + 1,
+			"organization":    map[string]any{"duns": fmt.Sprintf("duns_%d_%d", pageNumber, i+1)},
+		})
+	}
+
+	gock.New(dnbclient.BaseURLV1).
+		Post(endpoint).
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			reqBytes, err := io.ReadAll(req.Body)
+			if err != nil {
+				return false, err
+			}
+
+			req.Body = io.NopCloser(bytes.NewReader(reqBytes))
+
+			var body dnbclient.CompanySearchRequest
+			err = json.Unmarshal(reqBytes, &body)
+
+			return err == nil && body.PageNumber == pageNumber && body.PageSize == pageSize, err
+		}).
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"transactionDetail":          map[string]string{"transactionID": "test_transactionID"},
+			"candidatesMatchedQuantity":  matched,
+			"candidatesReturnedQuantity": returned,
+			"searchCandidates":           candidates,
+		})
+}
+
+func mockPartition(endpoint string, region string, matched int, duns []string, states []map[string]any) {
+	candidates := []map[string]any{}
+	for i, number := range duns {
+		candidates = append(candidates, map[string]any{
+			"displaySequence": i + 1,
+			"organization":    map[string]any{"duns": number},
+		})
+	}
+
+	gock.New(dnbclient.BaseURLV1).
+		Post(endpoint).
+		AddMatcher(func(req *http.Request, _ *gock.Request) (bool, error) {
+			reqBytes, err := io.ReadAll(req.Body)
+			if err != nil {
+				return false, err
+			}
+
+			req.Body = io.NopCloser(bytes.NewReader(reqBytes))
+
+			var body dnbclient.CompanySearchRequest
+			err = json.Unmarshal(reqBytes, &body)
+
+			return err == nil && body.AddressRegion == region, err
+		}).
+		Reply(http.StatusOK).
+		JSON(map[string]any{
+			"transactionDetail":          map[string]string{"transactionID": "test_transactionID"},
+			"candidatesMatchedQuantity":  matched,
+			"candidatesReturnedQuantity": len(candidates),
+			"searchCandidates":           candidates,
+			"navigators":                 map[string]any{"location": map[string]any{"state": states}},
+		})
+}
+
+func contactPage(pageNumber int, returned int, matched int, links map[string]string) map[string]any {
+	candidates := []map[string]any{}
+	for i := 0; i < returned; i++ {
+		candidates = append(candidates, map[string]any{
+			"contact": map[string]any{"id": fmt.Sprintf("contact_%d_%d", pageNumber, i+1)},
+		})
+	}
+
+	return map[string]any{
+		"transactionDetail":          map[string]string{"transactionID": "test_transactionID"},
+		"candidatesMatchedQuantity":  matched,
+		"candidatesReturnedQuantity": returned,
+		"searchCandidates":           candidates,
+		"links":                      links,
+	}
+}
+
+type recordingHook struct {
+	started []dnbclient.RequestInfo
+	ended   []dnbclient.RequestResult
+}
+
+func (hook *recordingHook) RequestStart(ctx context.Context, info *dnbclient.RequestInfo) context.Context {
+	hook.started = append(hook.started, *info)
+
+	return ctx
+}
+
+func (hook *recordingHook) RequestEnd(ctx context.Context, info *dnbclient.RequestInfo, result *dnbclient.RequestResult) {
+	hook.ended = append(hook.ended, *result)
+}
+
+type stubTransport struct {
+	status   int
+	body     string
+	requests []*http.Request
+}
+
+func (transport *stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport.requests = append(transport.requests, req)
+
+	return &http.Response{
+		StatusCode: transport.status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(transport.body)),
+		Request:    req,
+	}, nil
+}
+
 func saveToken(apiToken string) error {
 	f, err := os.OpenFile(".env", os.O_APPEND|os.O_WRONLY, 0600)
 	if err != nil {
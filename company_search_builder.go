@@ -0,0 +1,267 @@
+package dnbclient
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// CompanySearchBuilder builds a CompanySearchRequest and validates the
+// combination of the criteria when the request is built.
+//
+//	request, err := dnbclient.NewCompanySearch().
+//		Country("US").
+//		Employees(50, 500).
+//		Radius(40.7128, -74.0060, 25, "mi").
+//		Build()
+type CompanySearchBuilder struct {
+	request CompanySearchRequest
+}
+
+// NewCompanySearch returns an empty company search builder.
+func NewCompanySearch() *CompanySearchBuilder {
+	return &CompanySearchBuilder{}
+}
+
+func (builder *CompanySearchBuilder) SearchTerm(searchTerm string) *CompanySearchBuilder {
+	builder.request.SearchTerm = searchTerm
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) DUNS(duns DUNS) *CompanySearchBuilder {
+	builder.request.DUNS = duns
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) DUNSList(duns ...DUNS) *CompanySearchBuilder {
+	builder.request.DUNSList = append(builder.request.DUNSList, duns...)
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) PrimaryName(name string) *CompanySearchBuilder {
+	builder.request.PrimaryName = name
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) TradeStyleName(name string) *CompanySearchBuilder {
+	builder.request.TradeStyleName = name
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) Country(countryCode string) *CompanySearchBuilder {
+	builder.request.CountryISOAlpha2Code = countryCode
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) Region(region string) *CompanySearchBuilder {
+	builder.request.AddressRegion = region
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) Locality(locality string) *CompanySearchBuilder {
+	builder.request.AddressLocality = locality
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) StreetAddress(streetAddress string) *CompanySearchBuilder {
+	builder.request.StreetAddressLine1 = streetAddress
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) PostalCode(postalCode string) *CompanySearchBuilder {
+	builder.request.PostalCode = postalCode
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) Telephone(telephoneNumber string) *CompanySearchBuilder {
+	builder.request.TelephoneNumber = telephoneNumber
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) Domain(domain string) *CompanySearchBuilder {
+	builder.request.Domain = domain
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) TickerSymbol(tickerSymbol string) *CompanySearchBuilder {
+	builder.request.TickerSymbol = tickerSymbol
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) RegistrationNumbers(registrationNumbers ...string) *CompanySearchBuilder {
+	builder.request.RegistrationNumbers = append(builder.request.RegistrationNumbers, registrationNumbers...)
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) BusinessEntityTypes(businessEntityTypes ...string) *CompanySearchBuilder {
+	builder.request.BusinessEntityType = append(builder.request.BusinessEntityType, businessEntityTypes...)
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) FamilyTreeRoles(roles ...string) *CompanySearchBuilder {
+	builder.request.FamilytreeRolesPlayed = append(builder.request.FamilytreeRolesPlayed, roles...)
+
+	return builder
+}
+
+// SIC filters by US SIC v4 codes.
+func (builder *CompanySearchBuilder) SIC(codes ...string) *CompanySearchBuilder {
+	builder.request.UsSicv4 = append(builder.request.UsSicv4, codes...)
+
+	return builder
+}
+
+// IndustryCodes filters by industry codes of the D&B code type, e.g. 3599 for NAICS.
+func (builder *CompanySearchBuilder) IndustryCodes(typeDnbCode string, codes ...string) *CompanySearchBuilder {
+	builder.request.IndustryCodes = append(builder.request.IndustryCodes, &CompanyIndustryCode{
+		TypeDnbCode: typeDnbCode,
+		Code:        codes,
+	})
+
+	return builder
+}
+
+// Employees filters by the number of employees, a maximum of zero leaves the range open.
+func (builder *CompanySearchBuilder) Employees(minimum int, maximum int) *CompanySearchBuilder {
+	builder.request.NumberOfEmployees = &EmployeeRange{
+		MinimumValue: minimum,
+		MaximumValue: maximum,
+	}
+
+	return builder
+}
+
+// EmployeesScope sets the information scope of the employee range, e.g. 9066 for the individual site.
+func (builder *CompanySearchBuilder) EmployeesScope(informationScope int) *CompanySearchBuilder {
+	if builder.request.NumberOfEmployees == nil {
+		builder.request.NumberOfEmployees = &EmployeeRange{}
+	}
+
+	builder.request.NumberOfEmployees.InformationScope = informationScope
+
+	return builder
+}
+
+// Revenue filters by yearly revenue in USD, a maximum of zero leaves the range open.
+func (builder *CompanySearchBuilder) Revenue(minimum int, maximum int) *CompanySearchBuilder {
+	builder.request.YearlyRevenue = &ValueRange{
+		MinimumValue: minimum,
+		MaximumValue: maximum,
+	}
+
+	return builder
+}
+
+// FamilyTreeMembers filters by the number of members in the global ultimate family tree.
+func (builder *CompanySearchBuilder) FamilyTreeMembers(minimum int, maximum int) *CompanySearchBuilder {
+	builder.request.GlobalUltimateramilyTreeMembersCount = &ValueRange{
+		MinimumValue: minimum,
+		MaximumValue: maximum,
+	}
+
+	return builder
+}
+
+// Radius filters by the distance from a point, unit is mi or km.
+func (builder *CompanySearchBuilder) Radius(lat float64, lon float64, radius float64, unit string) *CompanySearchBuilder {
+	builder.request.LocationRadius = &LocationRadius{
+		Lat:    lat,
+		Lng:    lon,
+		Radius: radius,
+		Unit:   unit,
+	}
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) Marketable(isMarketable bool) *CompanySearchBuilder {
+	builder.request.IsMarketable = isMarketable
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) OutOfBusiness(isOutOfBusiness bool) *CompanySearchBuilder {
+	builder.request.IsOutOffBusiness = isOutOfBusiness
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) TelephoneDisconnected(isTelephoneDisconnected bool) *CompanySearchBuilder {
+	builder.request.IsTelephoneDisconnected = isTelephoneDisconnected
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) MailUndeliverable(isMailUndeliverable bool) *CompanySearchBuilder {
+	builder.request.IsMailUndeliverable = isMailUndeliverable
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) Standalone(isStandalone bool) *CompanySearchBuilder {
+	builder.request.IsStandalone = isStandalone
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) Importer(isImporter bool) *CompanySearchBuilder {
+	builder.request.IsImporter = isImporter
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) Exporter(isExporter bool) *CompanySearchBuilder {
+	builder.request.IsExporter = isExporter
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) Page(pageNumber int, pageSize int) *CompanySearchBuilder {
+	builder.request.PageNumber = pageNumber
+	builder.request.PageSize = pageSize
+
+	return builder
+}
+
+func (builder *CompanySearchBuilder) Navigators() *CompanySearchBuilder {
+	builder.request.ReturnNavigators = true
+
+	return builder
+}
+
+// Build validates the criteria and returns the request. All invalid criteria
+// are reported in the returned error.
+//
+// # Returns
+//
+// - CompanySearchRequest: the company search request
+//
+// - error: ErrInvalidRequest joined with a ValidationError for every violated field
+func (builder *CompanySearchBuilder) Build() (*CompanySearchRequest, error) {
+	request := builder.request
+
+	if reflect.ValueOf(request).IsZero() {
+		return nil, fmt.Errorf("%w, %w", ErrInvalidRequest, &ValidationError{Field: "request", Message: "has no search criteria"})
+	}
+
+	err := request.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &request, nil
+}
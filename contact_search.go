@@ -0,0 +1,169 @@
+package dnbclient
+
+import (
+	"context"
+	"errors"
+
+	"github.com/struki84/dnbclient/api_response"
+)
+
+// Contact search views
+const (
+	ContactViewStandard = "standard"
+	ContactViewPremium  = "premium"
+)
+
+// Standard Contact Search Request, holds the criteria supported by the
+// standard contact search.
+type StandardContactSearchRequest struct {
+	GivenName            string      `json:"givenName,omitempty"`
+	FamilyName           string      `json:"familyName,omitempty"`
+	JobTitles            []string    `json:"jobTitles,omitempty"`
+	Duns                 DUNS        `json:"duns,omitempty"`
+	PrimaryName          string      `json:"primaryName,omitempty"`
+	AddressLocality      string      `json:"addressLocality,omitempty"`
+	AddressRegion        string      `json:"addressRegion,omitempty"`
+	PostalCode           string      `json:"postalCode,omitempty"`
+	CountryISOAlpha2Code string      `json:"countryISOAlpha2Code,omitempty"`
+	ReturnNavigators     bool        `json:"returnNavigators,omitempty"`
+	PageNumber           int         `json:"pageNumber,omitempty"`
+	PageSize             int         `json:"pageSize,omitempty"`
+	Sort                 []*SortItem `json:"sort,omitempty"`
+}
+
+// Premium Contact Search Request, adds the criteria only the premium
+// contact search supports to the standard criteria.
+type PremiumContactSearchRequest struct {
+	StandardContactSearchRequest
+
+	ContactID     string          `json:"contactID,omitempty"`
+	ContactEmail  string          `json:"contactEmail,omitempty"`
+	HasDirectDial bool            `json:"hasDirectDial,omitempty"`
+	UsSicV4       []string        `json:"usSicV4,omitempty"`
+	MrcCode       []string        `json:"mrcCode,omitempty"`
+	IndustryCodes []*IndustryCode `json:"industryCodes,omitempty"`
+}
+
+func (request *StandardContactSearchRequest) contactSearch() *ContactSearchRequest {
+	return &ContactSearchRequest{
+		GivenName:            request.GivenName,
+		FamilyName:           request.FamilyName,
+		JobTitles:            request.JobTitles,
+		Duns:                 request.Duns,
+		PrimaryName:          request.PrimaryName,
+		AddressLocality:      request.AddressLocality,
+		AddressRegion:        request.AddressRegion,
+		PostalCode:           request.PostalCode,
+		CountryISOAlpha2Code: request.CountryISOAlpha2Code,
+		ReturnNavigators:     request.ReturnNavigators,
+		PageNumber:           request.PageNumber,
+		PageSize:             request.PageSize,
+		Sort:                 request.Sort,
+		View:                 ContactViewStandard,
+	}
+}
+
+func (request *PremiumContactSearchRequest) contactSearch() *ContactSearchRequest {
+	contactSearch := request.StandardContactSearchRequest.contactSearch()
+	contactSearch.ContactID = request.ContactID
+	contactSearch.ContactEmail = request.ContactEmail
+	contactSearch.HasDirectDial = request.HasDirectDial
+	contactSearch.UsSicV4 = request.UsSicV4
+	contactSearch.MrcCode = request.MrcCode
+	contactSearch.IndustryCodes = request.IndustryCodes
+	contactSearch.View = ContactViewPremium
+
+	return contactSearch
+}
+
+// hasPremiumCriteria reports whether the request uses criteria only the
+// premium contact search supports.
+func (request *ContactSearchRequest) hasPremiumCriteria() bool {
+	return request.ContactID != "" ||
+		request.ContactEmail != "" ||
+		request.HasDirectDial ||
+		len(request.UsSicV4) > 0 ||
+		len(request.MrcCode) > 0 ||
+		len(request.IndustryCodes) > 0
+}
+
+// SearchContactsStandard searches for contacts with the standard contact search.
+//
+// # Parameters
+//
+// - ctx
+//
+// - request: standard contact search criteria
+//
+// - options: allows setting the page with WithPage
+//
+// # Returns
+//
+// - ContactSearch: contact search results
+//
+// - error: error if any
+//
+// # Documentation
+//
+// - https://directplus.documentation.dnb.com/openAPI.html?apiID=searchContactsStandard
+func (client *Client) SearchContactsStandard(ctx context.Context, request *StandardContactSearchRequest, options ...RequestOptions) (*api_response.ContactSearch, error) {
+	options = append([]RequestOptions{WithContactSearchRequest(request.contactSearch())}, options...)
+
+	return client.SearchContact(ctx, options...)
+}
+
+// SearchContactsPremium searches for contacts with the premium contact search,
+// the account has to be entitled to premium contacts.
+//
+// # Parameters
+//
+// - ctx
+//
+// - request: premium contact search criteria
+//
+// - options: allows setting the page with WithPage
+//
+// # Returns
+//
+// - ContactSearch: contact search results
+//
+// - error: error if any, wraps ErrNotEntitled when the account is not entitled to premium contacts
+//
+// # Documentation
+//
+// - https://directplus.documentation.dnb.com/openAPI.html?apiID=searchContactsPremium
+func (client *Client) SearchContactsPremium(ctx context.Context, request *PremiumContactSearchRequest, options ...RequestOptions) (*api_response.ContactSearch, error) {
+	options = append([]RequestOptions{WithContactSearchRequest(request.contactSearch())}, options...)
+
+	return client.SearchContact(ctx, options...)
+}
+
+// SearchContactsWithFallback runs a premium contact search and repeats it as
+// a standard search with the standard criteria when the account is not
+// entitled to premium contacts.
+//
+// # Parameters
+//
+// - ctx
+//
+// - request: premium contact search criteria
+//
+// - options: allows setting the page with WithPage
+//
+// # Returns
+//
+// - ContactSearch: contact search results
+//
+// - string: view of the search that returned the results, ContactViewPremium or ContactViewStandard
+//
+// - error: error if any
+func (client *Client) SearchContactsWithFallback(ctx context.Context, request *PremiumContactSearchRequest, options ...RequestOptions) (*api_response.ContactSearch, string, error) {
+	searchResults, err := client.SearchContactsPremium(ctx, request, options...)
+	if !errors.Is(err, ErrNotEntitled) {
+		return searchResults, ContactViewPremium, err
+	}
+
+	searchResults, err = client.SearchContactsStandard(ctx, &request.StandardContactSearchRequest, options...)
+
+	return searchResults, ContactViewStandard, err
+}
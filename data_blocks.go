@@ -0,0 +1,155 @@
+package dnbclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/struki84/dnbclient/api_response"
+)
+
+// Data blocks with typed models in api_response.DataBlocksOrganization
+const (
+	CompanyInfoBlock              = "companyinfo"
+	PrincipalsContactsBlock       = "principalscontacts"
+	HierarchyConnectionsBlock     = "hierarchyconnections"
+	FinancialStrengthInsightBlock = "financialstrengthinsight"
+)
+
+// DataBlock identifies a data block at a level and version, e.g. companyinfo_L2_v1.
+type DataBlock struct {
+	Name    string
+	Level   int
+	Version int
+}
+
+// Block returns the first version of the data block at the level.
+func Block(name string, level int) DataBlock {
+	return DataBlock{
+		Name:    name,
+		Level:   level,
+		Version: 1,
+	}
+}
+
+// ParseDataBlock parses a block ID like companyinfo_L2_v1.
+//
+// # Parameters
+//
+// - blockID: block ID in the name_L<level>_v<version> notation
+//
+// # Returns
+//
+// - DataBlock: the data block
+//
+// - error: ErrInvalidRequest if the block ID is malformed
+func ParseDataBlock(blockID string) (DataBlock, error) {
+	parts := strings.Split(blockID, "_")
+	if len(parts) != 3 || parts[0] == "" || !strings.HasPrefix(parts[1], "L") || !strings.HasPrefix(parts[2], "v") {
+		return DataBlock{}, fmt.Errorf("%w, malformed block ID %q", ErrInvalidRequest, blockID)
+	}
+
+	level, err := strconv.Atoi(parts[1][1:])
+	if err != nil {
+		return DataBlock{}, fmt.Errorf("%w, malformed block ID %q", ErrInvalidRequest, blockID)
+	}
+
+	version, err := strconv.Atoi(parts[2][1:])
+	if err != nil {
+		return DataBlock{}, fmt.Errorf("%w, malformed block ID %q", ErrInvalidRequest, blockID)
+	}
+
+	return DataBlock{Name: parts[0], Level: level, Version: version}, nil
+}
+
+// WithVersion returns the data block at another version.
+func (block DataBlock) WithVersion(version int) DataBlock {
+	block.Version = version
+
+	return block
+}
+
+// String returns the block ID, the version defaults to 1.
+func (block DataBlock) String() string {
+	version := block.Version
+	if version < 1 {
+		version = 1
+	}
+
+	return fmt.Sprintf("%s_L%d_v%d", block.Name, block.Level, version)
+}
+
+// GetDataBlocks returns the data of the organization for the requested data
+// blocks. Blocks without a typed model are kept as raw JSON in
+// api_response.DataBlocksOrganization.Unknown.
+//
+// # Parameters
+//
+// - ctx
+//
+// - duns: D-U-N-S number of the organization
+//
+// - blockIDs: data blocks to return, e.g. Block(CompanyInfoBlock, 2)
+//
+// # Returns
+//
+// - DataBlocks: data of the organization
+//
+// - error: error if any
+//
+// # Documentation
+//
+// - https://directplus.documentation.dnb.com/openAPI.html?apiID=dataBlocks
+func (client *Client) GetDataBlocks(ctx context.Context, duns DUNS, blockIDs ...DataBlock) (*api_response.DataBlocks, error) {
+	dataBlocks := &api_response.DataBlocks{}
+	duns = duns.Normalize()
+
+	validator := &validator{}
+	validator.duns("duns", duns)
+
+	if duns == "" {
+		validator.add("duns", "is required")
+	}
+
+	if len(blockIDs) == 0 {
+		validator.add("blockIDs", "at least one block is required")
+	}
+
+	for _, block := range blockIDs {
+		if block.Name == "" || block.Level < 1 {
+			validator.add("blockIDs", "%q needs a name and a level", block.String())
+		}
+	}
+
+	err := client.validate(validator.err)
+	if err != nil {
+		return dataBlocks, fmt.Errorf("%w, %w", ErrGetDataBlocksFailed, err)
+	}
+
+	reqURL, err := url.Parse(client.BaseURL + DataBlocksURL + "/" + url.PathEscape(duns.String()))
+	if err != nil {
+		return dataBlocks, fmt.Errorf("%w, %w", ErrGetDataBlocksFailed, err)
+	}
+
+	ids := make([]string, 0, len(blockIDs))
+	for _, block := range blockIDs {
+		ids = append(ids, block.String())
+	}
+
+	params := reqURL.Query()
+	params.Add("blockIDs", strings.Join(ids, ","))
+	reqURL.RawQuery = params.Encode()
+
+	err = client.do(ctx, http.MethodGet, reqURL.String(), nil, RequestInfo{
+		Endpoint: DataBlocksURL,
+		DUNS:     duns.String(),
+	}, dataBlocks)
+	if err != nil {
+		return dataBlocks, fmt.Errorf("%w, %w", ErrGetDataBlocksFailed, err)
+	}
+
+	return dataBlocks, nil
+}
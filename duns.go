@@ -0,0 +1,25 @@
+package dnbclient
+
+import "github.com/struki84/dnbclient/api_response"
+
+// DUNS is a 9 digit D-U-N-S number, request fields of this type are
+// normalized when they are sent and validated before.
+type DUNS = api_response.DUNS
+
+var ErrInvalidDUNS = api_response.ErrInvalidDUNS
+
+// ParseDUNS normalizes a D-U-N-S number, stripping separators and padding
+// numbers that lost their leading zeros to 9 digits.
+//
+// # Parameters
+//
+// - value: D-U-N-S number, e.g. 15-048-3782 or 150483782
+//
+// # Returns
+//
+// - DUNS: normalized D-U-N-S number
+//
+// - error: ErrInvalidDUNS if the value is not a D-U-N-S number
+func ParseDUNS(value string) (DUNS, error) {
+	return api_response.ParseDUNS(value)
+}
@@ -0,0 +1,311 @@
+package dnbclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/struki84/dnbclient/api_response"
+)
+
+// Maximum page size of the family tree endpoint
+const FamilyTreeMaxPageSize = 1000
+
+// Family tree roles played by a member, api_response.DnbCode.DnbCode of
+// api_response.HierarchyConnections.FamilytreeRolesPlayed
+const (
+	FamilyTreeRoleBranch             = 12769
+	FamilyTreeRoleSubsidiary         = 12771
+	FamilyTreeRoleParentHeadquarters = 12773
+	FamilyTreeRoleDomesticUltimate   = 12774
+	FamilyTreeRoleGlobalUltimate     = 12775
+)
+
+// FamilyTree is the corporate family tree of a global ultimate, every
+// member is linked to its parent, or to its headquarters for branches.
+type FamilyTree struct {
+	// Global ultimate of the family tree
+	Root *FamilyTreeNode
+
+	// Members whose parent was not returned by the API
+	Orphans []*FamilyTreeNode
+
+	// Number of members of the family tree reported by the API
+	MembersCount int
+
+	nodes map[DUNS]*FamilyTreeNode
+}
+
+// FamilyTreeNode is a single member of a family tree.
+type FamilyTreeNode struct {
+	Member   api_response.FamilyTreeMember
+	Parent   *FamilyTreeNode
+	Children []*FamilyTreeNode
+}
+
+// newFamilyTree links the members to their parents, keeping the order the
+// API returned them in.
+func newFamilyTree(globalUltimate DUNS, membersCount int, members []api_response.FamilyTreeMember) *FamilyTree {
+	tree := &FamilyTree{
+		MembersCount: membersCount,
+		nodes:        make(map[DUNS]*FamilyTreeNode, len(members)),
+	}
+
+	nodes := make([]*FamilyTreeNode, 0, len(members))
+
+	for _, member := range members {
+		duns := member.Duns.Normalize()
+		if _, ok := tree.nodes[duns]; ok {
+			continue
+		}
+
+		node := &FamilyTreeNode{Member: member}
+		tree.nodes[duns] = node
+		nodes = append(nodes, node)
+	}
+
+	for _, node := range nodes {
+		parentDUNS := node.parentDUNS()
+
+		parent, ok := tree.nodes[parentDUNS]
+		if ok && parent != node {
+			node.Parent = parent
+			parent.Children = append(parent.Children, node)
+
+			continue
+		}
+
+		if tree.Root == nil && (node.DUNS() == globalUltimate.Normalize() || parentDUNS == "") {
+			tree.Root = node
+
+			continue
+		}
+
+		tree.Orphans = append(tree.Orphans, node)
+	}
+
+	return tree
+}
+
+// Len returns the number of members in the tree.
+func (tree *FamilyTree) Len() int {
+	return len(tree.nodes)
+}
+
+// Find returns the member with the D-U-N-S number.
+func (tree *FamilyTree) Find(duns DUNS) (*FamilyTreeNode, bool) {
+	node, ok := tree.nodes[duns.Normalize()]
+
+	return node, ok
+}
+
+// Walk visits the root and its descendants, followed by the orphans and
+// their descendants, parents before their children. The walk stops when fn
+// returns false.
+func (tree *FamilyTree) Walk(fn func(node *FamilyTreeNode) bool) {
+	if tree.Root != nil && !tree.Root.Walk(fn) {
+		return
+	}
+
+	for _, orphan := range tree.Orphans {
+		if !orphan.Walk(fn) {
+			return
+		}
+	}
+}
+
+// DUNS returns the D-U-N-S number of the member.
+func (node *FamilyTreeNode) DUNS() DUNS {
+	return node.Member.Duns.Normalize()
+}
+
+// HierarchyLevel returns the level of the member in the tree, the global
+// ultimate is level 1.
+func (node *FamilyTreeNode) HierarchyLevel() int {
+	return node.Member.CorporateLinkage.HierarchyLevel
+}
+
+// HasRole reports whether the member plays the family tree role, e.g.
+// FamilyTreeRoleDomesticUltimate.
+func (node *FamilyTreeNode) HasRole(role int) bool {
+	for _, played := range node.Member.CorporateLinkage.FamilytreeRolesPlayed {
+		if played.DnbCode == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsBranch reports whether the member is a branch or division, which is
+// linked to its headquarters instead of a parent.
+func (node *FamilyTreeNode) IsBranch() bool {
+	return node.HasRole(FamilyTreeRoleBranch) || node.Member.CorporateLinkage.HeadQuarter != nil
+}
+
+// IsSubsidiary reports whether the member is a legal entity owned by its parent.
+func (node *FamilyTreeNode) IsSubsidiary() bool {
+	return !node.IsBranch() && (node.HasRole(FamilyTreeRoleSubsidiary) || node.Member.CorporateLinkage.Parent != nil)
+}
+
+// IsGlobalUltimate reports whether the member is the top of the tree.
+func (node *FamilyTreeNode) IsGlobalUltimate() bool {
+	return node.HasRole(FamilyTreeRoleGlobalUltimate) || (node.Parent == nil && node.parentDUNS() == "")
+}
+
+// Ultimate returns the topmost ancestor of the member, the global ultimate
+// unless the member is an orphan.
+func (node *FamilyTreeNode) Ultimate() *FamilyTreeNode {
+	for node.Parent != nil {
+		node = node.Parent
+	}
+
+	return node
+}
+
+// Walk visits the member and its descendants, parents before their
+// children. The walk stops when fn returns false, Walk then returns false.
+func (node *FamilyTreeNode) Walk(fn func(node *FamilyTreeNode) bool) bool {
+	if !fn(node) {
+		return false
+	}
+
+	for _, child := range node.Children {
+		if !child.Walk(fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Find returns the member with the D-U-N-S number from the subtree of the member.
+func (node *FamilyTreeNode) Find(duns DUNS) (*FamilyTreeNode, bool) {
+	duns = duns.Normalize()
+
+	var found *FamilyTreeNode
+
+	node.Walk(func(node *FamilyTreeNode) bool {
+		if node.DUNS() == duns {
+			found = node
+		}
+
+		return found == nil
+	})
+
+	return found, found != nil
+}
+
+// SubtreeSize returns the number of members in the subtree, including the member.
+func (node *FamilyTreeNode) SubtreeSize() int {
+	size := 0
+
+	node.Walk(func(*FamilyTreeNode) bool {
+		size++
+
+		return true
+	})
+
+	return size
+}
+
+func (node *FamilyTreeNode) parentDUNS() DUNS {
+	linkage := node.Member.CorporateLinkage
+
+	switch {
+	case linkage.HeadQuarter != nil:
+		return linkage.HeadQuarter.Duns.Normalize()
+	case linkage.Parent != nil:
+		return linkage.Parent.Duns.Normalize()
+	default:
+		return ""
+	}
+}
+
+// GetFamilyTree returns the full corporate family tree the organization
+// belongs to, fetching all pages of large trees.
+//
+// # Parameters
+//
+// - ctx
+//
+// - duns: D-U-N-S number of any member of the family tree
+//
+// # Returns
+//
+// - FamilyTree: family tree with the global ultimate as root
+//
+// - error: error if any
+//
+// # Documentation
+//
+// - https://directplus.documentation.dnb.com/openAPI.html?apiID=familyTreeFull
+func (client *Client) GetFamilyTree(ctx context.Context, duns DUNS) (*FamilyTree, error) {
+	duns = duns.Normalize()
+
+	validator := &validator{}
+	validator.duns("duns", duns)
+
+	if duns == "" {
+		validator.add("duns", "is required")
+	}
+
+	err := client.validate(validator.err)
+	if err != nil {
+		return newFamilyTree("", 0, nil), fmt.Errorf("%w, %w", ErrGetFamilyTreeFailed, err)
+	}
+
+	reqURL, err := url.Parse(client.BaseURL + FamilyTreeURL + "/" + url.PathEscape(duns.String()))
+	if err != nil {
+		return newFamilyTree("", 0, nil), fmt.Errorf("%w, %w", ErrGetFamilyTreeFailed, err)
+	}
+
+	params := reqURL.Query()
+	params.Set("page[number]", "1")
+	params.Set("page[size]", strconv.Itoa(FamilyTreeMaxPageSize))
+	reqURL.RawQuery = params.Encode()
+
+	var globalUltimate DUNS
+
+	membersCount := 0
+	members := []api_response.FamilyTreeMember{}
+	requested := map[string]bool{}
+
+	for !requested[reqURL.String()] {
+		requested[reqURL.String()] = true
+
+		page := &api_response.FamilyTree{}
+
+		err = client.do(ctx, http.MethodGet, reqURL.String(), nil, RequestInfo{
+			Endpoint: FamilyTreeURL,
+			DUNS:     duns.String(),
+		}, page)
+		if err != nil {
+			return newFamilyTree(globalUltimate, membersCount, members), fmt.Errorf("%w, %w", ErrGetFamilyTreeFailed, err)
+		}
+
+		globalUltimate = page.GlobalUltimateDuns
+		membersCount = page.GlobalUltimateFamilyTreeMembersCount
+		members = append(members, page.FamilyTreeMembers...)
+
+		// The pages end with an empty page or a page without a next link, the
+		// members count is optional and only guards against pages that
+		// return more members than the tree has.
+		if len(page.FamilyTreeMembers) == 0 || page.Links.Next == "" {
+			break
+		}
+
+		if membersCount > 0 && len(members) > membersCount {
+			break
+		}
+
+		reqURL, err = client.resolveLink(page.Links.Next)
+		if err != nil {
+			return newFamilyTree(globalUltimate, membersCount, members), fmt.Errorf("%w, %w", ErrGetFamilyTreeFailed, err)
+		}
+	}
+
+	return newFamilyTree(globalUltimate, membersCount, members), nil
+}
@@ -0,0 +1,106 @@
+package dnbclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/struki84/dnbclient/api_response"
+)
+
+// RequestHook is called around every call to the D&B Direct+ API, including
+// all of its retries. It can be used to plug in tracing and instrumentation.
+type RequestHook interface {
+	// RequestStart is called before the request is sent, the returned context
+	// is used for the request and passed to RequestEnd.
+	RequestStart(ctx context.Context, info *RequestInfo) context.Context
+
+	// RequestEnd is called once the call finished.
+	RequestEnd(ctx context.Context, info *RequestInfo, result *RequestResult)
+}
+
+// RequestInfo describes the call passed to the request hooks.
+type RequestInfo struct {
+	// HTTP method of the request
+	Method string
+
+	// Endpoint the request is sent to, e.g. CriteriaSearchURL
+	Endpoint string
+
+	// DUNS the call is made for, if any
+	DUNS string
+
+	// Search term of the call, if any
+	SearchTerm string
+}
+
+// RequestResult describes the outcome of a call passed to the request hooks.
+type RequestResult struct {
+	// HTTP status code of the last attempt, zero when no response was received
+	StatusCode int
+
+	// D&B transaction ID
+	TransactionID string
+
+	// Number of candidates matched by the search
+	CandidatesMatchedQuantity int
+
+	// Number of candidates returned in the response
+	CandidatesReturnedQuantity int
+
+	// Number of attempts made
+	Attempts int
+
+	// Total duration of the call including retries
+	Latency time.Duration
+
+	// Error returned to the caller
+	Err error
+}
+
+func (client *Client) startHooks(ctx context.Context, info *RequestInfo) context.Context {
+	for _, hook := range client.hooks {
+		ctx = hook.RequestStart(ctx, info)
+	}
+
+	return ctx
+}
+
+// endHooks completes the result with the transaction details from the
+// response body or the API error and passes it to the hooks in reverse order.
+func (client *Client) endHooks(ctx context.Context, info *RequestInfo, result *RequestResult, body []byte) {
+	if len(client.hooks) == 0 {
+		return
+	}
+
+	base := api_response.Base{}
+	if json.Unmarshal(body, &base) == nil {
+		result.TransactionID = base.TransactionDetail.TransactionID
+		result.CandidatesMatchedQuantity = base.CandidatesMatchedQuantity
+		result.CandidatesReturnedQuantity = base.CandidatesReturnedQuantity
+	}
+
+	var apiError *APIError
+	if errors.As(result.Err, &apiError) {
+		result.TransactionID = apiError.TransactionID
+	}
+
+	for i := len(client.hooks) - 1; i >= 0; i-- {
+		client.hooks[i].RequestEnd(ctx, info, result)
+	}
+}
+
+type requestInfoKey struct{}
+
+// withRequestInfo attaches the call details known only to the endpoint
+// methods to the request context.
+func withRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+func requestInfoFrom(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(RequestInfo)
+
+	return info
+}
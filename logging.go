@@ -0,0 +1,104 @@
+package dnbclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/struki84/dnbclient/api_response"
+)
+
+// LogLevels sets the levels at which requests are logged.
+type LogLevels struct {
+	// Level of successful requests
+	Success slog.Level
+
+	// Level of failed attempts that are going to be retried
+	Retry slog.Level
+
+	// Level of failed requests
+	Failure slog.Level
+}
+
+// Levels used when no levels are passed to WithLogLevels.
+var DefaultLogLevels = LogLevels{
+	Success: slog.LevelDebug,
+	Retry:   slog.LevelWarn,
+	Failure: slog.LevelError,
+}
+
+// logAttempt logs a single attempt of a request. Only the method, the
+// endpoint path and response metadata are logged, query parameters, headers
+// and bodies can hold tokens or personal data and are left out.
+func (client *Client) logAttempt(req *http.Request, attempt int, res *http.Response, body []byte, err error, latency time.Duration, retrying bool) {
+	if client.logger == nil {
+		return
+	}
+
+	level := client.logLevels.Success
+	message := "dnb request"
+
+	if err != nil || res.StatusCode != http.StatusOK {
+		level = client.logLevels.Failure
+		message = "dnb request failed"
+
+		if retrying {
+			level = client.logLevels.Retry
+			message = "dnb request failed, retrying"
+		}
+	}
+
+	ctx := req.Context()
+	if !client.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("endpoint", client.endpoint(req)),
+		slog.Int("attempt", attempt),
+		slog.Duration("latency", latency),
+	}
+
+	if res != nil {
+		attrs = append(attrs, slog.Int("status", res.StatusCode))
+	}
+
+	if transactionID := transactionID(body); transactionID != "" {
+		attrs = append(attrs, slog.String("transaction_id", transactionID))
+	}
+
+	if err != nil {
+		// Transport errors carry the full url including the query.
+		var urlError *url.Error
+		if errors.As(err, &urlError) {
+			err = urlError.Err
+		}
+
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	client.logger.LogAttrs(context.WithoutCancel(ctx), level, message, attrs...)
+}
+
+// transactionID reads the D&B transaction ID from a response body.
+func transactionID(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var response struct {
+		TransactionDetail api_response.TransactionDetail `json:"transactionDetail"`
+	}
+
+	err := json.Unmarshal(body, &response)
+	if err != nil {
+		return ""
+	}
+
+	return response.TransactionDetail.TransactionID
+}
@@ -0,0 +1,289 @@
+package dnbclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/struki84/dnbclient/api_response"
+)
+
+const (
+	// Maximum number of candidates a match returns
+	MatchMaxCandidates = 25
+
+	// Lowest and highest confidence code of a match candidate
+	MatchMinConfidenceCode = 1
+	MatchMaxConfidenceCode = 10
+)
+
+// Cleanse Match Request, the identifying data of an entity that is matched
+// against the Dun & Bradstreet Data Cloud.
+type CleanseMatchRequest struct {
+	DUNS                 DUNS   `json:"duns,omitempty"`
+	Name                 string `json:"name,omitempty"`
+	StreetAddressLine1   string `json:"streetAddressLine1,omitempty"`
+	StreetAddressLine2   string `json:"streetAddressLine2,omitempty"`
+	AddressLocality      string `json:"addressLocality,omitempty"`
+	AddressRegion        string `json:"addressRegion,omitempty"`
+	PostalCode           string `json:"postalCode,omitempty"`
+	CountryISOAlpha2Code string `json:"countryISOAlpha2Code,omitempty"`
+	TelephoneNumber      string `json:"telephoneNumber,omitempty"`
+	URL                  string `json:"url,omitempty"`
+	Email                string `json:"email,omitempty"`
+	RegistrationNumber   string `json:"registrationNumber,omitempty"`
+	InLanguage           string `json:"inLanguage,omitempty"`
+	CustomerReference    string `json:"customerReference,omitempty"`
+
+	CandidateMaximumQuantity                   int  `json:"candidateMaximumQuantity,omitempty"`
+	ConfidenceLowerLevelThresholdValue         int  `json:"confidenceLowerLevelThresholdValue,omitempty"`
+	IsCleanseAndStandardizeInformationRequired bool `json:"isCleanseAndStandardizeInformationRequired,omitempty"`
+}
+
+// NewCleanseMatchRequest returns a cleanse match request with the name,
+// address, phone and domain of a company search request.
+func NewCleanseMatchRequest(companySearch *CompanySearchRequest) *CleanseMatchRequest {
+	request := &CleanseMatchRequest{
+		DUNS:                 companySearch.DUNS,
+		Name:                 companySearch.PrimaryName,
+		StreetAddressLine1:   companySearch.StreetAddressLine1,
+		AddressLocality:      companySearch.AddressLocality,
+		AddressRegion:        companySearch.AddressRegion,
+		PostalCode:           companySearch.PostalCode,
+		CountryISOAlpha2Code: companySearch.CountryISOAlpha2Code,
+		TelephoneNumber:      companySearch.TelephoneNumber,
+		URL:                  companySearch.Domain,
+	}
+
+	if request.Name == "" {
+		request.Name = companySearch.SearchTerm
+	}
+
+	if len(companySearch.RegistrationNumbers) > 0 {
+		request.RegistrationNumber = companySearch.RegistrationNumbers[0]
+	}
+
+	return request
+}
+
+// queryParams encodes the populated fields as query parameters, the cleanse
+// match endpoint does not accept a request body.
+func (request *CleanseMatchRequest) queryParams() url.Values {
+	params := url.Values{}
+
+	addString := func(key string, value string) {
+		if value != "" {
+			params.Add(key, value)
+		}
+	}
+
+	addInt := func(key string, value int) {
+		if value > 0 {
+			params.Add(key, strconv.Itoa(value))
+		}
+	}
+
+	addString("duns", request.DUNS.Normalize().String())
+	addString("name", request.Name)
+	addString("streetAddressLine1", request.StreetAddressLine1)
+	addString("streetAddressLine2", request.StreetAddressLine2)
+	addString("addressLocality", request.AddressLocality)
+	addString("addressRegion", request.AddressRegion)
+	addString("postalCode", request.PostalCode)
+	addString("countryISOAlpha2Code", request.CountryISOAlpha2Code)
+	addString("telephoneNumber", request.TelephoneNumber)
+	addString("url", request.URL)
+	addString("email", request.Email)
+	addString("registrationNumber", request.RegistrationNumber)
+	addString("inLanguage", request.InLanguage)
+	addString("customerReference", request.CustomerReference)
+	addInt("candidateMaximumQuantity", request.CandidateMaximumQuantity)
+	addInt("confidenceLowerLevelThresholdValue", request.ConfidenceLowerLevelThresholdValue)
+
+	if request.IsCleanseAndStandardizeInformationRequired {
+		params.Add("isCleanseAndStandardizeInformationRequired", "true")
+	}
+
+	return params
+}
+
+// Validate checks the request against the documented Direct+ limits.
+//
+// # Returns
+//
+// - error: ErrInvalidRequest joined with a ValidationError for every violated field
+func (request *CleanseMatchRequest) Validate() error {
+	validator := &validator{}
+
+	validator.duns("duns", request.DUNS)
+
+	if request.CountryISOAlpha2Code == "" {
+		validator.add("countryISOAlpha2Code", "is required")
+	}
+
+	validator.country("countryISOAlpha2Code", request.CountryISOAlpha2Code)
+
+	if request.DUNS == "" && request.Name == "" && request.TelephoneNumber == "" && request.RegistrationNumber == "" && request.URL == "" && request.Email == "" {
+		validator.add("name", "or one of duns, telephoneNumber, registrationNumber, url and email is required")
+	}
+
+	validator.candidates(request.CandidateMaximumQuantity, request.ConfidenceLowerLevelThresholdValue)
+
+	return validator.err()
+}
+
+// Extended match exclusion criteria, values of the exclusionCriteria query parameter
+const (
+	ExclusionUndeliverable   = "ExcludeUndeliverable"
+	ExclusionUnreachable     = "ExcludeUnreachable"
+	ExclusionNonHeadQuarters = "ExcludeNonHeadQuarters"
+	ExclusionOutOfBusiness   = "ExcludeOutofBusiness"
+	ExclusionNonMarketable   = "ExcludeNonMarketable"
+)
+
+// Extended Match Request, adds the exclusion criteria of the extended match
+// to the cleanse match criteria. Every flag that is set adds its criterion
+// to the exclusionCriteria list, the API excludes nothing by default.
+type ExtendedMatchRequest struct {
+	CleanseMatchRequest
+
+	ExcludeUndeliverable   bool
+	ExcludeUnreachable     bool
+	ExcludeNonHeadQuarters bool
+	ExcludeOutOfBusiness   bool
+	ExcludeNonMarketable   bool
+}
+
+// exclusionCriteria returns the criteria of the flags that are set.
+func (request *ExtendedMatchRequest) exclusionCriteria() []string {
+	criteria := []string{}
+
+	if request.ExcludeUndeliverable {
+		criteria = append(criteria, ExclusionUndeliverable)
+	}
+
+	if request.ExcludeUnreachable {
+		criteria = append(criteria, ExclusionUnreachable)
+	}
+
+	if request.ExcludeNonHeadQuarters {
+		criteria = append(criteria, ExclusionNonHeadQuarters)
+	}
+
+	if request.ExcludeOutOfBusiness {
+		criteria = append(criteria, ExclusionOutOfBusiness)
+	}
+
+	if request.ExcludeNonMarketable {
+		criteria = append(criteria, ExclusionNonMarketable)
+	}
+
+	return criteria
+}
+
+// queryParams encodes the cleanse match criteria and the exclusion criteria.
+func (request *ExtendedMatchRequest) queryParams() url.Values {
+	params := request.CleanseMatchRequest.queryParams()
+
+	for _, criterion := range request.exclusionCriteria() {
+		params.Add("exclusionCriteria", criterion)
+	}
+
+	return params
+}
+
+func (validator *validator) candidates(candidateMaximumQuantity int, confidenceLowerLevel int) {
+	if candidateMaximumQuantity < 0 || candidateMaximumQuantity > MatchMaxCandidates {
+		validator.add("candidateMaximumQuantity", "must be between 1 and %d", MatchMaxCandidates)
+	}
+
+	if confidenceLowerLevel != 0 && (confidenceLowerLevel < MatchMinConfidenceCode || confidenceLowerLevel > MatchMaxConfidenceCode) {
+		validator.add("confidenceLowerLevelThresholdValue", "must be between %d and %d", MatchMinConfidenceCode, MatchMaxConfidenceCode)
+	}
+}
+
+// CleanseMatch resolves the identity of an entity from its name, address,
+// phone and other identifying data, returning the candidates ranked by
+// their match quality.
+//
+// # Parameters
+//
+// - ctx
+//
+// - request: identifying data of the entity, NewCleanseMatchRequest builds it from a company search request
+//
+// # Returns
+//
+// - Match: match candidates with their match quality information
+//
+// - error: error if any
+//
+// # Documentation
+//
+// - https://directplus.documentation.dnb.com/openAPI.html?apiID=IDRCleanseMatch
+func (client *Client) CleanseMatch(ctx context.Context, request *CleanseMatchRequest) (*api_response.Match, error) {
+	match, err := client.match(ctx, CleanseMatchURL, request, request.queryParams())
+	if err != nil {
+		return match, fmt.Errorf("%w, %w", ErrCleanseMatchFailed, err)
+	}
+
+	return match, nil
+}
+
+// ExtendedMatch resolves the identity of an entity like CleanseMatch, with
+// exclusion criteria for undeliverable, unreachable, out of business and
+// non-marketable entities and for entities that are not headquarters. The candidates have the same type as the CleanseMatch candidates.
+//
+// # Parameters
+//
+// - ctx
+//
+// - request: identifying data of the entity with the exclusion flags
+//
+// # Returns
+//
+// - Match: match candidates with their match quality information
+//
+// - error: error if any
+//
+// # Documentation
+//
+// - https://directplus.documentation.dnb.com/openAPI.html?apiID=IDRExtendedMatch
+func (client *Client) ExtendedMatch(ctx context.Context, request *ExtendedMatchRequest) (*api_response.Match, error) {
+	match, err := client.match(ctx, ExtendedMatchURL, &request.CleanseMatchRequest, request.queryParams())
+	if err != nil {
+		return match, fmt.Errorf("%w, %w", ErrExtendedMatchFailed, err)
+	}
+
+	return match, nil
+}
+
+func (client *Client) match(ctx context.Context, endpoint string, request *CleanseMatchRequest, params url.Values) (*api_response.Match, error) {
+	match := &api_response.Match{}
+
+	err := client.validate(request.Validate)
+	if err != nil {
+		return match, err
+	}
+
+	reqURL, err := url.Parse(client.BaseURL + endpoint)
+	if err != nil {
+		return match, err
+	}
+
+	reqURL.RawQuery = params.Encode()
+
+	err = client.do(ctx, http.MethodGet, reqURL.String(), nil, RequestInfo{
+		DUNS:       request.DUNS.Normalize().String(),
+		SearchTerm: request.Name,
+	}, match)
+	if err != nil {
+		return match, err
+	}
+
+	client.recordCandidates(endpoint, match.Base)
+
+	return match, nil
+}
@@ -0,0 +1,44 @@
+package dnbclient
+
+import (
+	"errors"
+	"time"
+
+	"github.com/struki84/dnbclient/api_response"
+)
+
+// MetricsRecorder collects usage metrics of the D&B Direct+ API. The client
+// records every call once it finished, including all of its retries.
+type MetricsRecorder interface {
+	// RecordRequest is called for every call, statusCode is zero when no
+	// response was received and errorCode holds the D&B error code of a
+	// failed call.
+	RecordRequest(endpoint string, statusCode int, errorCode string, latency time.Duration)
+
+	// RecordCandidates is called by the search and match methods with the
+	// number of candidates matched and returned by the search.
+	RecordCandidates(endpoint string, matched int, returned int)
+}
+
+func (client *Client) recordRequest(endpoint string, statusCode int, err error, latency time.Duration) {
+	if client.metrics == nil {
+		return
+	}
+
+	errorCode := ""
+
+	var apiError *APIError
+	if errors.As(err, &apiError) {
+		errorCode = apiError.ErrorCode
+	}
+
+	client.metrics.RecordRequest(endpoint, statusCode, errorCode, latency)
+}
+
+func (client *Client) recordCandidates(endpoint string, base api_response.Base) {
+	if client.metrics == nil {
+		return
+	}
+
+	client.metrics.RecordCandidates(endpoint, base.CandidatesMatchedQuantity, base.CandidatesReturnedQuantity)
+}
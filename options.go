@@ -1,5 +1,11 @@
 package dnbclient
 
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
 type ClientOptions func(*Client)
 
 func WithBaseURL(baseURL string) ClientOptions {
@@ -8,6 +14,70 @@ func WithBaseURL(baseURL string) ClientOptions {
 	}
 }
 
+func WithTokenURL(tokenURL string) ClientOptions {
+	return func(client *Client) {
+		client.TokenURL = tokenURL
+	}
+}
+
+func WithHTTPClient(httpClient *http.Client) ClientOptions {
+	return func(client *Client) {
+		if httpClient == nil {
+			httpClient = http.DefaultClient
+		}
+
+		client.httpClient = httpClient
+	}
+}
+
+func WithTransport(transport http.RoundTripper) ClientOptions {
+	return func(client *Client) {
+		client.transport = transport
+	}
+}
+
+func WithRetryPolicy(policy RetryPolicy) ClientOptions {
+	return func(client *Client) {
+		client.retryPolicy = policy
+	}
+}
+
+func WithRateLimit(endpoint string, requestsPerSecond float64, burst int) ClientOptions {
+	return func(client *Client) {
+		client.limiter.SetLimit(endpoint, requestsPerSecond, burst)
+	}
+}
+
+func WithLogger(logger *slog.Logger) ClientOptions {
+	return func(client *Client) {
+		client.logger = logger
+	}
+}
+
+func WithLogLevels(levels LogLevels) ClientOptions {
+	return func(client *Client) {
+		client.logLevels = levels
+	}
+}
+
+func WithRequestHook(hook RequestHook) ClientOptions {
+	return func(client *Client) {
+		client.hooks = append(client.hooks, hook)
+	}
+}
+
+func WithMetricsRecorder(recorder MetricsRecorder) ClientOptions {
+	return func(client *Client) {
+		client.metrics = recorder
+	}
+}
+
+func WithMiddleware(middlewares ...Middleware) ClientOptions {
+	return func(client *Client) {
+		client.middlewares = append(client.middlewares, middlewares...)
+	}
+}
+
 func WithCredentials(username string, password string) ClientOptions {
 	return func(client *Client) {
 		client.username = username
@@ -28,20 +98,67 @@ func WithAPIToken(apiToken string) ClientOptions {
 	}
 }
 
-func WithCompanySerchRequest(companySearch *CompanySearchRequest) ClientOptions {
+func WithTokenRefreshWindow(window time.Duration) ClientOptions {
 	return func(client *Client) {
-		client.RequestBody.CompanySearch = companySearch
+		client.tokens.refreshWindow = window
 	}
 }
 
-func WithContactSearchRequest(contactSearch *ContactSearchRequest) ClientOptions {
+func WithTokenTimeout(timeout time.Duration) ClientOptions {
 	return func(client *Client) {
-		client.RequestBody.ContactSearch = contactSearch
+		client.tokens.timeout = timeout
 	}
 }
 
-func WithDUNS(duns string) ClientOptions {
+func WithoutValidation() ClientOptions {
 	return func(client *Client) {
-		client.RequestBody.CompanySearch.DUNS = duns
+		client.skipValidation = true
+	}
+}
+
+type RequestOptions func(*RequestBody)
+
+func WithCompanySerchRequest(companySearch *CompanySearchRequest) RequestOptions {
+	return func(body *RequestBody) {
+		if companySearch == nil {
+			return
+		}
+
+		*body.CompanySearch = *companySearch
+	}
+}
+
+func WithContactSearchRequest(contactSearch *ContactSearchRequest) RequestOptions {
+	return func(body *RequestBody) {
+		if contactSearch == nil {
+			return
+		}
+
+		*body.ContactSearch = *contactSearch
+	}
+}
+
+func WithTypeheadSearchRequest(typeheadSearch *TypeheadSearchRequest) RequestOptions {
+	return func(body *RequestBody) {
+		if typeheadSearch == nil {
+			return
+		}
+
+		*body.TypeheadSearch = *typeheadSearch
+	}
+}
+
+func WithDUNS(duns DUNS) RequestOptions {
+	return func(body *RequestBody) {
+		body.CompanySearch.DUNS = duns.Normalize()
+	}
+}
+
+func WithPage(pageNumber int, pageSize int) RequestOptions {
+	return func(body *RequestBody) {
+		body.CompanySearch.PageNumber = pageNumber
+		body.CompanySearch.PageSize = pageSize
+		body.ContactSearch.PageNumber = pageNumber
+		body.ContactSearch.PageSize = pageSize
 	}
 }
@@ -0,0 +1,101 @@
+// Package otelhook provides an OpenTelemetry request hook for the D&B client
+// that creates a span for every call to the Direct+ API.
+package otelhook
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/struki84/dnbclient"
+)
+
+// Name of the tracer used when no tracer provider is passed in.
+const TracerName = "github.com/struki84/dnbclient"
+
+// Span attribute keys
+const (
+	EndpointKey           = attribute.Key("dnb.endpoint")
+	DUNSKey               = attribute.Key("dnb.duns")
+	SearchTermKey         = attribute.Key("dnb.search_term")
+	TransactionIDKey      = attribute.Key("dnb.transaction_id")
+	CandidatesMatchedKey  = attribute.Key("dnb.candidates_matched")
+	CandidatesReturnedKey = attribute.Key("dnb.candidates_returned")
+	AttemptsKey           = attribute.Key("dnb.attempts")
+	HTTPMethodKey         = attribute.Key("http.request.method")
+	HTTPResponseStatusKey = attribute.Key("http.response.status_code")
+)
+
+type Hook struct {
+	tracer trace.Tracer
+}
+
+// New creates the hook, pass it to the client with dnbclient.WithRequestHook.
+//
+// # Parameters
+//
+// - provider: tracer provider used to create spans, the global provider is
+// used when nil
+//
+// # Returns
+//
+// - Hook: OpenTelemetry request hook
+func New(provider trace.TracerProvider) *Hook {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+
+	return &Hook{
+		tracer: provider.Tracer(TracerName),
+	}
+}
+
+func (hook *Hook) RequestStart(ctx context.Context, info *dnbclient.RequestInfo) context.Context {
+	attrs := []attribute.KeyValue{
+		EndpointKey.String(info.Endpoint),
+		HTTPMethodKey.String(info.Method),
+	}
+
+	if info.DUNS != "" {
+		attrs = append(attrs, DUNSKey.String(info.DUNS))
+	}
+
+	if info.SearchTerm != "" {
+		attrs = append(attrs, SearchTermKey.String(info.SearchTerm))
+	}
+
+	ctx, _ = hook.tracer.Start(ctx, "dnb "+info.Endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+
+	return ctx
+}
+
+func (hook *Hook) RequestEnd(ctx context.Context, info *dnbclient.RequestInfo, result *dnbclient.RequestResult) {
+	span := trace.SpanFromContext(ctx)
+
+	span.SetAttributes(
+		AttemptsKey.Int(result.Attempts),
+		CandidatesMatchedKey.Int(result.CandidatesMatchedQuantity),
+		CandidatesReturnedKey.Int(result.CandidatesReturnedQuantity),
+	)
+
+	if result.StatusCode != 0 {
+		span.SetAttributes(HTTPResponseStatusKey.Int(result.StatusCode))
+	}
+
+	if result.TransactionID != "" {
+		span.SetAttributes(TransactionIDKey.String(result.TransactionID))
+	}
+
+	if result.Err != nil {
+		span.RecordError(result.Err)
+		span.SetStatus(codes.Error, result.Err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,102 @@
+package otelhook_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/struki84/dnbclient"
+	"github.com/struki84/dnbclient/otelhook"
+)
+
+func TestHook(t *testing.T) {
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	client, _ := dnbclient.NewClient(
+		dnbclient.WithRequestHook(otelhook.New(provider)),
+	)
+
+	t.Run("Unit Test: Span is created for a search", func(t *testing.T) {
+		defer gock.Off()
+		defer exporter.Reset()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			Reply(http.StatusOK).
+			JSON(map[string]any{
+				"transactionDetail":          map[string]string{"transactionID": "test_transactionID"},
+				"candidatesMatchedQuantity":  120,
+				"candidatesReturnedQuantity": 10,
+			})
+
+		_, err := client.CriteriaSearch(
+			context.Background(),
+			dnbclient.WithCompanySerchRequest(&dnbclient.CompanySearchRequest{
+				SearchTerm: "test_search_term",
+			}),
+		)
+		assert.NoError(t, err)
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1)
+
+		span := spans[0]
+		assert.Equal(t, "dnb "+dnbclient.CriteriaSearchURL, span.Name)
+
+		attrs := attributes(span.Attributes)
+		assert.Equal(t, dnbclient.CriteriaSearchURL, attrs[otelhook.EndpointKey].AsString())
+		assert.Equal(t, "test_search_term", attrs[otelhook.SearchTermKey].AsString())
+		assert.Equal(t, "test_transactionID", attrs[otelhook.TransactionIDKey].AsString())
+		assert.Equal(t, int64(120), attrs[otelhook.CandidatesMatchedKey].AsInt64())
+		assert.Equal(t, int64(10), attrs[otelhook.CandidatesReturnedKey].AsInt64())
+		assert.Equal(t, int64(http.StatusOK), attrs[otelhook.HTTPResponseStatusKey].AsInt64())
+		assert.Equal(t, codes.Unset, span.Status.Code)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Failed call marks the span as error", func(t *testing.T) {
+		defer gock.Off()
+		defer exporter.Reset()
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.ContactSearchURL).
+			Reply(http.StatusNotFound).
+			JSON(map[string]any{
+				"transactionDetail": map[string]string{"transactionID": "test_transactionID"},
+				"error":             map[string]string{"errorCode": "10001", "errorMessage": "test_error_message"},
+			})
+
+		_, err := client.GetContactByDUNS(context.Background(), "804735132")
+		assert.Error(t, err)
+
+		spans := exporter.GetSpans()
+		assert.Len(t, spans, 1)
+
+		span := spans[0]
+		attrs := attributes(span.Attributes)
+		assert.Equal(t, "804735132", attrs[otelhook.DUNSKey].AsString())
+		assert.Equal(t, int64(http.StatusNotFound), attrs[otelhook.HTTPResponseStatusKey].AsInt64())
+		assert.Equal(t, codes.Error, span.Status.Code)
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+}
+
+func attributes(kvs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range kvs {
+		attrs[kv.Key] = kv.Value
+	}
+
+	return attrs
+}
@@ -0,0 +1,470 @@
+package dnbclient
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/struki84/dnbclient/api_response"
+)
+
+const (
+	// Maximum number of results criteria search can return
+	CriteriaSearchMaxResults = 1000
+
+	// Maximum number of results company list search can return
+	CompanyListMaxResults = 10000
+
+	// Page size used by the iterators when the request does not set one
+	DefaultPageSize = 25
+)
+
+// CompanySearchResult is a single organization returned by a company iterator.
+type CompanySearchResult struct {
+	Organization api_response.Organization
+
+	// Page the organization was returned on
+	PageNumber int
+
+	// Position of the organization in the search results
+	DisplaySequence int
+}
+
+// CompanyIterator walks the results of a company search page by page.
+//
+//	iterator := client.CriteriaSearchIterator(dnbclient.WithCompanySerchRequest(request))
+//	for iterator.Next(ctx) {
+//		result := iterator.Result()
+//	}
+//	if err := iterator.Err(); err != nil {
+//		...
+//	}
+type CompanyIterator struct {
+	search     func(ctx context.Context, options ...RequestOptions) (*api_response.CompanySearch, error)
+	options    []RequestOptions
+	maxResults int
+	pageNumber int
+	pageSize   int
+
+	page    []CompanySearchResult
+	index   int
+	current CompanySearchResult
+	seen    int
+	matched int
+	last    bool
+	done    bool
+	err     error
+}
+
+// CriteriaSearchIterator returns an iterator over all results of a criteria
+// search, up to the 1000 results the endpoint allows. Paging set in the
+// request options is used as the starting page and page size.
+//
+// # Parameters
+//
+// - options: allows configuring the search
+//
+// # Returns
+//
+// - CompanyIterator: iterator over the search results
+func (client *Client) CriteriaSearchIterator(options ...RequestOptions) *CompanyIterator {
+	return newCompanyIterator(client.CriteriaSearch, CriteriaSearchMaxResults, options)
+}
+
+// CompanyListIterator returns an iterator over all results of a company list
+// search, up to the 10000 results the endpoint allows. Paging set in the
+// request options is used as the starting page and page size.
+//
+// # Parameters
+//
+// - options: allows configuring the search
+//
+// # Returns
+//
+// - CompanyIterator: iterator over the search results
+func (client *Client) CompanyListIterator(options ...RequestOptions) *CompanyIterator {
+	return newCompanyIterator(client.CompanyListSearch, CompanyListMaxResults, options)
+}
+
+func newCompanyIterator(
+	search func(ctx context.Context, options ...RequestOptions) (*api_response.CompanySearch, error),
+	maxResults int,
+	options []RequestOptions,
+) *CompanyIterator {
+	request := newRequestBody(options...).CompanySearch
+
+	iterator := &CompanyIterator{
+		search:     search,
+		options:    options,
+		maxResults: maxResults,
+		pageNumber: request.PageNumber,
+		pageSize:   request.PageSize,
+	}
+
+	if iterator.pageNumber < 1 {
+		iterator.pageNumber = 1
+	}
+
+	if iterator.pageSize < 1 {
+		iterator.pageSize = DefaultPageSize
+	}
+
+	// Results before the starting page count towards the endpoint cap.
+	iterator.seen = (iterator.pageNumber - 1) * iterator.pageSize
+
+	return iterator
+}
+
+// Next advances to the next result, fetching the next page when the current
+// one is exhausted. It returns false when all results were read, the context
+// is done, the iterator was stopped or a request failed.
+func (iterator *CompanyIterator) Next(ctx context.Context) bool {
+	if iterator.done {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		iterator.err = err
+		iterator.done = true
+
+		return false
+	}
+
+	if iterator.index >= len(iterator.page) {
+		if iterator.last || !iterator.fetch(ctx) {
+			iterator.done = true
+
+			return false
+		}
+	}
+
+	iterator.current = iterator.page[iterator.index]
+	iterator.index++
+
+	return true
+}
+
+// Result returns the result Next advanced to.
+func (iterator *CompanyIterator) Result() CompanySearchResult {
+	return iterator.current
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (iterator *CompanyIterator) Err() error {
+	return iterator.err
+}
+
+// Matched returns the number of candidates the search matched, known after
+// the first page was fetched.
+func (iterator *CompanyIterator) Matched() int {
+	return iterator.matched
+}
+
+// Stop ends the iteration early, no more pages are fetched.
+func (iterator *CompanyIterator) Stop() {
+	iterator.done = true
+}
+
+func (iterator *CompanyIterator) fetch(ctx context.Context) bool {
+	if iterator.seen >= iterator.maxResults {
+		return false
+	}
+
+	options := append(iterator.options[:len(iterator.options):len(iterator.options)], WithPage(iterator.pageNumber, iterator.pageSize))
+
+	searchResults, err := iterator.search(ctx, options...)
+	if err != nil {
+		iterator.err = err
+
+		return false
+	}
+
+	iterator.matched = searchResults.CandidatesMatchedQuantity
+	iterator.page = iterator.page[:0]
+	iterator.index = 0
+
+	for _, candidate := range searchResults.Candidates {
+		iterator.page = append(iterator.page, CompanySearchResult{
+			Organization:    candidate.Organization,
+			PageNumber:      iterator.pageNumber,
+			DisplaySequence: candidate.DisplaySequence,
+		})
+	}
+
+	iterator.seen += iterator.pageSize
+	iterator.pageNumber++
+
+	limit := min(iterator.matched, iterator.maxResults)
+	if len(searchResults.Candidates) < iterator.pageSize || iterator.seen >= limit {
+		iterator.last = true
+	}
+
+	return len(iterator.page) > 0
+}
+
+// Maximum number of results contact search can return
+const ContactSearchMaxResults = 1000
+
+// ContactPager walks the pages of a contact search, following the links
+// returned by the API and falling back to the page number when the response
+// carries no links. The API only returns the first, self and last links, the
+// next page is the self link with the following page number.
+//
+//	pager := client.ContactsByDUNSPager(duns)
+//	for pager.Next(ctx) {
+//		contact := pager.Contact()
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type ContactPager struct {
+	fetch      func(ctx context.Context, pageNumber int, pageSize int, link string) (*api_response.ContactSearch, error)
+	pageNumber int
+	pageSize   int
+	link       string
+
+	page    []api_response.Contact
+	index   int
+	current api_response.Contact
+	seen    int
+	matched int
+	last    bool
+	done    bool
+	err     error
+}
+
+// SearchContactPager returns a pager over all results of a contact search.
+// Paging set in the request options is used as the starting page and page size.
+// The search criteria are sent in the body of a POST request and are not part
+// of the links, so the pager repeats the search with the page number of the
+// link instead of requesting the link itself.
+//
+// # Parameters
+//
+// - options: allows configuring the search
+//
+// # Returns
+//
+// - ContactPager: pager over the contacts
+func (client *Client) SearchContactPager(options ...RequestOptions) *ContactPager {
+	fetch := func(ctx context.Context, pageNumber int, pageSize int, link string) (*api_response.ContactSearch, error) {
+		if link != "" {
+			linkURL, err := client.resolveLink(link)
+			if err != nil {
+				return nil, fmt.Errorf("%w, %w", ErrContactSearchFailed, err)
+			}
+
+			if linkPage, err := strconv.Atoi(linkURL.Query().Get("pageNumber")); err == nil {
+				pageNumber = linkPage
+			}
+		}
+
+		options := append(options[:len(options):len(options)], WithPage(pageNumber, pageSize))
+
+		return client.SearchContact(ctx, options...)
+	}
+
+	return newContactPager(fetch, options)
+}
+
+// ContactsByDUNSPager returns a pager over all contacts of the DUNS.
+// Paging set in the request options is used as the starting page and page size.
+//
+// # Parameters
+//
+// - duns: D-U-N-S number of the organization
+//
+// - options: allows setting the first page with WithPage
+//
+// # Returns
+//
+// - ContactPager: pager over the contacts
+func (client *Client) ContactsByDUNSPager(duns DUNS, options ...RequestOptions) *ContactPager {
+	fetch := func(ctx context.Context, pageNumber int, pageSize int, link string) (*api_response.ContactSearch, error) {
+		if link == "" {
+			return client.GetContactByDUNS(ctx, duns, WithPage(pageNumber, pageSize))
+		}
+
+		reqURL, err := client.resolveLink(link)
+		if err != nil {
+			return nil, fmt.Errorf("%w, %w", ErrGetContactsFailed, err)
+		}
+
+		return client.getContact(ctx, reqURL, RequestInfo{DUNS: duns.Normalize().String()})
+	}
+
+	return newContactPager(fetch, options)
+}
+
+func newContactPager(
+	fetch func(ctx context.Context, pageNumber int, pageSize int, link string) (*api_response.ContactSearch, error),
+	options []RequestOptions,
+) *ContactPager {
+	request := newRequestBody(options...).ContactSearch
+
+	pager := &ContactPager{
+		fetch:      fetch,
+		pageNumber: request.PageNumber,
+		pageSize:   request.PageSize,
+	}
+
+	if pager.pageNumber < 1 {
+		pager.pageNumber = 1
+	}
+
+	if pager.pageSize < 1 {
+		pager.pageSize = DefaultPageSize
+	}
+
+	pager.seen = (pager.pageNumber - 1) * pager.pageSize
+
+	return pager
+}
+
+// Next advances to the next contact, fetching the next page when the current
+// one is exhausted. It returns false when all contacts were read, the context
+// is done, the pager was stopped or a request failed.
+func (pager *ContactPager) Next(ctx context.Context) bool {
+	if pager.done {
+		return false
+	}
+
+	if err := ctx.Err(); err != nil {
+		pager.err = err
+		pager.done = true
+
+		return false
+	}
+
+	if pager.index >= len(pager.page) {
+		if pager.last || !pager.fetchPage(ctx) {
+			pager.done = true
+
+			return false
+		}
+	}
+
+	pager.current = pager.page[pager.index]
+	pager.index++
+
+	return true
+}
+
+// Contact returns the contact Next advanced to.
+func (pager *ContactPager) Contact() api_response.Contact {
+	return pager.current
+}
+
+// Err returns the error that stopped the pager, if any.
+func (pager *ContactPager) Err() error {
+	return pager.err
+}
+
+// Matched returns the number of contacts the search matched, known after
+// the first page was fetched.
+func (pager *ContactPager) Matched() int {
+	return pager.matched
+}
+
+// Stop ends the paging early, no more pages are fetched.
+func (pager *ContactPager) Stop() {
+	pager.done = true
+}
+
+// All reads the remaining contacts. When a page fails the contacts read
+// before the failure are returned together with the error.
+func (pager *ContactPager) All(ctx context.Context) ([]api_response.Contact, error) {
+	contacts := []api_response.Contact{}
+
+	for pager.Next(ctx) {
+		contacts = append(contacts, pager.Contact())
+	}
+
+	return contacts, pager.Err()
+}
+
+func (pager *ContactPager) fetchPage(ctx context.Context) bool {
+	if pager.seen >= ContactSearchMaxResults {
+		return false
+	}
+
+	searchResults, err := pager.fetch(ctx, pager.pageNumber, pager.pageSize, pager.link)
+	if err != nil {
+		pager.err = err
+
+		return false
+	}
+
+	pager.matched = searchResults.CandidatesMatchedQuantity
+	pager.page = pager.page[:0]
+	pager.index = 0
+
+	for _, candidate := range searchResults.Candidates {
+		pager.page = append(pager.page, candidate.Contact)
+	}
+
+	pager.seen += pager.pageSize
+	pager.pageNumber++
+	pager.link = nextPageLink(searchResults.Links)
+
+	links := searchResults.Links
+	limit := min(pager.matched, ContactSearchMaxResults)
+
+	switch {
+	case links.Last != "" && links.Self == links.Last:
+		pager.last = true
+	case len(searchResults.Candidates) < pager.pageSize && pager.link == "":
+		pager.last = true
+	case pager.seen >= limit:
+		pager.last = true
+	}
+
+	return len(pager.page) > 0
+}
+
+// nextPageLink returns the self link with the following page number, empty
+// when the response has no self link or self is the last page.
+func nextPageLink(links api_response.CompanyLinks) string {
+	if links.Self == "" || links.Self == links.Last {
+		return ""
+	}
+
+	selfURL, err := url.Parse(links.Self)
+	if err != nil {
+		return ""
+	}
+
+	params := selfURL.Query()
+
+	pageNumber, err := strconv.Atoi(params.Get("pageNumber"))
+	if err != nil {
+		return ""
+	}
+
+	params.Set("pageNumber", strconv.Itoa(pageNumber+1))
+	selfURL.RawQuery = params.Encode()
+
+	return selfURL.String()
+}
+
+// resolveLink turns a link returned by the API into a request url. Links
+// pointing to another host or using another scheme are rejected so the
+// token is never sent there or over plain http.
+func (client *Client) resolveLink(link string) (*url.URL, error) {
+	baseURL, err := url.Parse(client.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	linkURL, err := baseURL.Parse(link)
+	if err != nil {
+		return nil, err
+	}
+
+	if linkURL.Host != baseURL.Host || linkURL.Scheme != baseURL.Scheme {
+		return nil, fmt.Errorf("%w, %s://%s", ErrInvalidLink, linkURL.Scheme, linkURL.Host)
+	}
+
+	return linkURL, nil
+}
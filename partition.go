@@ -0,0 +1,353 @@
+package dnbclient
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/struki84/dnbclient/api_response"
+)
+
+// PartitionDimension describes how a search is split along one of the
+// navigators returned by the search.
+type PartitionDimension struct {
+	// Name of the dimension, e.g. state
+	Name string
+
+	// Navigators returns the navigator buckets of the dimension.
+	Navigators func(navigators api_response.CompanyNavigators) []api_response.CompanyNavigator
+
+	// Apply narrows the request down to the navigator bucket, it returns
+	// false when the request can not be narrowed down by the bucket.
+	Apply func(request *CompanySearchRequest, navigator api_response.CompanyNavigator) bool
+}
+
+var (
+	// Splits the search by the state, region or province of the address
+	PartitionByState = PartitionDimension{
+		Name: "state",
+		Navigators: func(navigators api_response.CompanyNavigators) []api_response.CompanyNavigator {
+			return navigators.Location.State
+		},
+		Apply: func(request *CompanySearchRequest, navigator api_response.CompanyNavigator) bool {
+			if request.AddressRegion != "" {
+				return false
+			}
+
+			request.AddressRegion = navigator.Name
+
+			return true
+		},
+	}
+
+	// Splits the search by the city of the address
+	PartitionByCity = PartitionDimension{
+		Name: "city",
+		Navigators: func(navigators api_response.CompanyNavigators) []api_response.CompanyNavigator {
+			return navigators.Location.City
+		},
+		Apply: func(request *CompanySearchRequest, navigator api_response.CompanyNavigator) bool {
+			if request.AddressLocality != "" {
+				return false
+			}
+
+			request.AddressLocality = navigator.Name
+
+			return true
+		},
+	}
+
+	// Splits the search by the US SIC industry code
+	PartitionByIndustry = PartitionDimension{
+		Name: "industry",
+		Navigators: func(navigators api_response.CompanyNavigators) []api_response.CompanyNavigator {
+			return navigators.Industry
+		},
+		Apply: func(request *CompanySearchRequest, navigator api_response.CompanyNavigator) bool {
+			code := strings.TrimSpace(strings.SplitN(navigator.Name, " ", 2)[0])
+			if len(request.UsSicv4) > 0 || code == "" {
+				return false
+			}
+
+			request.UsSicv4 = []string{code}
+
+			return true
+		},
+	}
+
+	// Splits the search by employee bands, e.g. 50-99 or 10000+
+	PartitionByEmployees = PartitionDimension{
+		Name: "employees",
+		Navigators: func(navigators api_response.CompanyNavigators) []api_response.CompanyNavigator {
+			return navigators.NumberOfEmployees
+		},
+		Apply: func(request *CompanySearchRequest, navigator api_response.CompanyNavigator) bool {
+			minimum, maximum, ok := parseBand(navigator.Name)
+			if request.NumberOfEmployees != nil || !ok {
+				return false
+			}
+
+			request.NumberOfEmployees = &EmployeeRange{
+				MinimumValue: minimum,
+				MaximumValue: maximum,
+			}
+
+			return true
+		},
+	}
+
+	// Dimensions used when a partitioner is created without any
+	DefaultPartitionDimensions = []PartitionDimension{
+		PartitionByState,
+		PartitionByCity,
+		PartitionByIndustry,
+		PartitionByEmployees,
+	}
+)
+
+// PartitionResult holds the merged results of a partitioned search.
+type PartitionResult struct {
+	// Organizations found, deduplicated by DUNS
+	Organizations []api_response.Organization
+
+	// Number of candidates the original search matched
+	Matched int
+
+	// Number of requests sent to the API
+	Requests int
+
+	// Partitions still over the result cap that could not be split further,
+	// only the first results of these partitions were retrieved
+	Uncovered []CompanySearchRequest
+}
+
+// Coverage returns the share of the matched candidates that were retrieved.
+func (result *PartitionResult) Coverage() float64 {
+	if result.Matched == 0 {
+		return 1
+	}
+
+	return min(float64(len(result.Organizations))/float64(result.Matched), 1)
+}
+
+// Partitioner runs searches that match more candidates than the endpoint
+// returns by splitting them recursively into sub-searches under the cap.
+type Partitioner struct {
+	// Dimensions tried in order to split a search that is over the cap
+	Dimensions []PartitionDimension
+
+	// Page size of the requests, a page size set on the request takes precedence
+	PageSize int
+
+	search     func(ctx context.Context, options ...RequestOptions) (*api_response.CompanySearch, error)
+	maxResults int
+}
+
+// CriteriaSearchPartitioner returns a partitioner for criteria search, which
+// returns at most 1000 results for a search.
+//
+// # Parameters
+//
+// - dimensions: dimensions used to split the search, DefaultPartitionDimensions when empty
+//
+// # Returns
+//
+// - Partitioner: criteria search partitioner
+func (client *Client) CriteriaSearchPartitioner(dimensions ...PartitionDimension) *Partitioner {
+	return newPartitioner(client.CriteriaSearch, CriteriaSearchMaxResults, dimensions)
+}
+
+// CompanyListPartitioner returns a partitioner for company list search, which
+// returns at most 10000 results for a search.
+//
+// # Parameters
+//
+// - dimensions: dimensions used to split the search, DefaultPartitionDimensions when empty
+//
+// # Returns
+//
+// - Partitioner: company list search partitioner
+func (client *Client) CompanyListPartitioner(dimensions ...PartitionDimension) *Partitioner {
+	return newPartitioner(client.CompanyListSearch, CompanyListMaxResults, dimensions)
+}
+
+func newPartitioner(
+	search func(ctx context.Context, options ...RequestOptions) (*api_response.CompanySearch, error),
+	maxResults int,
+	dimensions []PartitionDimension,
+) *Partitioner {
+	if len(dimensions) == 0 {
+		dimensions = DefaultPartitionDimensions
+	}
+
+	return &Partitioner{
+		Dimensions: dimensions,
+		PageSize:   DefaultPageSize,
+		search:     search,
+		maxResults: maxResults,
+	}
+}
+
+// Search runs the search and merges the results of all partitions. When a
+// request fails the results gathered so far are returned with the error.
+//
+// # Parameters
+//
+// - ctx
+//
+// - options: allows configuring the search
+//
+// # Returns
+//
+// - PartitionResult: merged search results and coverage
+//
+// - error: error if any
+func (partitioner *Partitioner) Search(ctx context.Context, options ...RequestOptions) (*PartitionResult, error) {
+	request := *newRequestBody(options...).CompanySearch
+
+	run := &partitionRun{
+		partitioner: partitioner,
+		pageSize:    partitioner.PageSize,
+		result:      &PartitionResult{},
+		seen:        map[DUNS]bool{},
+	}
+
+	if request.PageSize > 0 {
+		run.pageSize = request.PageSize
+	}
+
+	err := run.search(ctx, request, 0, true)
+
+	return run.result, err
+}
+
+type partitionRun struct {
+	partitioner *Partitioner
+	pageSize    int
+	result      *PartitionResult
+	seen        map[DUNS]bool
+}
+
+func (run *partitionRun) search(ctx context.Context, request CompanySearchRequest, dimension int, root bool) error {
+	request.ReturnNavigators = true
+	request.PageNumber = 1
+	request.PageSize = run.pageSize
+
+	searchResults, err := run.fetch(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if root {
+		run.result.Matched = searchResults.CandidatesMatchedQuantity
+	}
+
+	if searchResults.CandidatesMatchedQuantity > run.partitioner.maxResults {
+		for ; dimension < len(run.partitioner.Dimensions); dimension++ {
+			partitions := run.split(request, searchResults.Navigators, run.partitioner.Dimensions[dimension])
+			if len(partitions) == 0 {
+				continue
+			}
+
+			for _, partition := range partitions {
+				err = run.search(ctx, partition, dimension+1, false)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}
+
+		run.result.Uncovered = append(run.result.Uncovered, request)
+	}
+
+	return run.collect(ctx, request, searchResults)
+}
+
+// split returns a sub-search for every navigator bucket of the dimension.
+func (run *partitionRun) split(request CompanySearchRequest, navigators api_response.CompanyNavigators, dimension PartitionDimension) []CompanySearchRequest {
+	partitions := []CompanySearchRequest{}
+
+	for _, navigator := range dimension.Navigators(navigators) {
+		if navigator.Count == 0 {
+			continue
+		}
+
+		partition := request
+		if !dimension.Apply(&partition, navigator) {
+			return nil
+		}
+
+		partitions = append(partitions, partition)
+	}
+
+	return partitions
+}
+
+// collect adds the first page and fetches the remaining pages of a search
+// that is within the cap.
+func (run *partitionRun) collect(ctx context.Context, request CompanySearchRequest, searchResults *api_response.CompanySearch) error {
+	request.ReturnNavigators = false
+	limit := min(searchResults.CandidatesMatchedQuantity, run.partitioner.maxResults)
+
+	for {
+		for _, candidate := range searchResults.Candidates {
+			// Candidates without a DUNS can not be told apart and are all kept.
+			duns := candidate.Organization.Duns.Normalize()
+			if duns != "" && run.seen[duns] {
+				continue
+			}
+
+			run.seen[duns] = true
+			run.result.Organizations = append(run.result.Organizations, candidate.Organization)
+		}
+
+		if len(searchResults.Candidates) < request.PageSize || request.PageNumber*request.PageSize >= limit {
+			return nil
+		}
+
+		request.PageNumber++
+
+		var err error
+
+		searchResults, err = run.fetch(ctx, request)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (run *partitionRun) fetch(ctx context.Context, request CompanySearchRequest) (*api_response.CompanySearch, error) {
+	run.result.Requests++
+
+	return run.partitioner.search(ctx, WithCompanySerchRequest(&request))
+}
+
+// parseBand parses navigator bands like 50-99, 10000+ or 1,000-4,999.
+func parseBand(band string) (int, int, bool) {
+	band = strings.ReplaceAll(strings.TrimSpace(band), ",", "")
+
+	if strings.HasSuffix(band, "+") {
+		minimum, err := strconv.Atoi(strings.TrimSuffix(band, "+"))
+
+		return minimum, 0, err == nil
+	}
+
+	minimumValue, maximumValue, found := strings.Cut(band, "-")
+	if !found {
+		return 0, 0, false
+	}
+
+	minimum, err := strconv.Atoi(strings.TrimSpace(minimumValue))
+	if err != nil {
+		return 0, 0, false
+	}
+
+	maximum, err := strconv.Atoi(strings.TrimSpace(maximumValue))
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return minimum, maximum, true
+}
@@ -0,0 +1,76 @@
+package dnbclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// Handler sends a request to the D&B Direct+ API and returns the body of the
+// successful response.
+type Handler func(req *http.Request) ([]byte, error)
+
+// Middleware wraps the handler every call of the client goes through. It can
+// change the request, answer it without calling next, e.g. from a cache, or
+// inspect the outcome. Middlewares run before the client authorizes, retries
+// and instruments the request. The token request does not go through the
+// middlewares, they never see the api key and secret.
+type Middleware func(next Handler) Handler
+
+// buildPipeline chains the middlewares in the order they were added in
+// front of authorization and runRequest.
+func (client *Client) buildPipeline() Handler {
+	handler := client.authorizeMiddleware(client.runRequest)
+
+	for i := len(client.middlewares) - 1; i >= 0; i-- {
+		handler = client.middlewares[i](handler)
+	}
+
+	return handler
+}
+
+// authorizeMiddleware sets the bearer token on requests that do not carry
+// credentials yet.
+func (client *Client) authorizeMiddleware(next Handler) Handler {
+	return func(req *http.Request) ([]byte, error) {
+		if req.Header.Get("Authorization") == "" {
+			err := client.authorize(req.Context(), req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return next(req)
+	}
+}
+
+// do sends a call through the pipeline, body is sent as JSON when set and
+// the response is decoded into result.
+func (client *Client) do(ctx context.Context, method string, reqURL string, body any, info RequestInfo, result any) error {
+	var reqBody io.Reader
+
+	if body != nil {
+		reqBytes, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		reqBody = bytes.NewReader(reqBytes)
+	}
+
+	req, err := http.NewRequestWithContext(withRequestInfo(ctx, info), method, reqURL, reqBody)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Add("Content-Type", "application/json")
+
+	responseBody, err := client.pipeline(req)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(responseBody, result)
+}
@@ -0,0 +1,106 @@
+// Package prommetrics provides a Prometheus implementation of the
+// dnbclient.MetricsRecorder interface.
+package prommetrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace of all collected metrics.
+const Namespace = "dnb"
+
+type Recorder struct {
+	requests   *prometheus.CounterVec
+	errors     *prometheus.CounterVec
+	latency    *prometheus.HistogramVec
+	candidates *prometheus.HistogramVec
+}
+
+// New creates the recorder and registers its metrics, pass it to the client
+// with dnbclient.WithMetricsRecorder.
+//
+// # Parameters
+//
+// - registerer: registry the metrics are registered with, the default
+// Prometheus registry is used when nil
+//
+// # Returns
+//
+// - Recorder: Prometheus metrics recorder
+//
+// - error: error if the metrics could not be registered
+func New(registerer prometheus.Registerer) (*Recorder, error) {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+
+	recorder := &Recorder{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "requests_total",
+			Help:      "Number of calls to the D&B Direct+ API.",
+		}, []string{"endpoint", "status"}),
+
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "request_errors_total",
+			Help:      "Number of failed calls to the D&B Direct+ API by D&B error code.",
+		}, []string{"endpoint", "error_code"}),
+
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of calls to the D&B Direct+ API including retries.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"endpoint"}),
+
+		candidates: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "candidates_returned",
+			Help:      "Number of candidates returned by a search.",
+			Buckets:   []float64{0, 1, 5, 10, 25, 50, 100, 250, 500, 1000},
+		}, []string{"endpoint"}),
+	}
+
+	collectors := []prometheus.Collector{
+		recorder.requests,
+		recorder.errors,
+		recorder.latency,
+		recorder.candidates,
+	}
+
+	for _, collector := range collectors {
+		err := registerer.Register(collector)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return recorder, nil
+}
+
+func (recorder *Recorder) RecordRequest(endpoint string, statusCode int, errorCode string, latency time.Duration) {
+	status := "none"
+	if statusCode != 0 {
+		status = strconv.Itoa(statusCode)
+	}
+
+	recorder.requests.WithLabelValues(endpoint, status).Inc()
+	recorder.latency.WithLabelValues(endpoint).Observe(latency.Seconds())
+
+	if statusCode != http.StatusOK {
+		if errorCode == "" {
+			errorCode = "none"
+		}
+
+		recorder.errors.WithLabelValues(endpoint, errorCode).Inc()
+	}
+}
+
+func (recorder *Recorder) RecordCandidates(endpoint string, matched int, returned int) {
+	recorder.candidates.WithLabelValues(endpoint).Observe(float64(returned))
+}
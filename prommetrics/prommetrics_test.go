@@ -0,0 +1,115 @@
+package prommetrics_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/struki84/dnbclient"
+	"github.com/struki84/dnbclient/prommetrics"
+)
+
+func TestRecorder(t *testing.T) {
+
+	registry := prometheus.NewRegistry()
+
+	recorder, err := prommetrics.New(registry)
+	assert.NoError(t, err)
+
+	client, _ := dnbclient.NewClient(
+		dnbclient.WithMetricsRecorder(recorder),
+	)
+
+	t.Run("Unit Test: Calls are counted per endpoint and error code", func(t *testing.T) {
+		defer gock.Off()
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			Reply(http.StatusOK).
+			JSON(map[string]any{
+				"transactionDetail":          map[string]string{"transactionID": "test_transactionID"},
+				"candidatesMatchedQuantity":  120,
+				"candidatesReturnedQuantity": 10,
+			})
+
+		gock.New(dnbclient.BaseURLV1).
+			Post(dnbclient.CriteriaSearchURL).
+			Reply(http.StatusBadRequest).
+			JSON(map[string]any{
+				"error": map[string]string{"errorCode": "10001", "errorMessage": "test_error_message"},
+			})
+
+		_, err := client.CriteriaSearch(context.Background())
+		assert.NoError(t, err)
+
+		_, err = client.CriteriaSearch(context.Background())
+		assert.Error(t, err)
+
+		expected := `
+# HELP dnb_requests_total Number of calls to the D&B Direct+ API.
+# TYPE dnb_requests_total counter
+dnb_requests_total{endpoint="/search/criteria",status="200"} 1
+dnb_requests_total{endpoint="/search/criteria",status="400"} 1
+# HELP dnb_request_errors_total Number of failed calls to the D&B Direct+ API by D&B error code.
+# TYPE dnb_request_errors_total counter
+dnb_request_errors_total{endpoint="/search/criteria",error_code="10001"} 1
+`
+		err = testutil.GatherAndCompare(registry, strings.NewReader(expected), "dnb_requests_total", "dnb_request_errors_total")
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1, testutil.CollectAndCount(registry, "dnb_candidates_returned"))
+		assert.Equal(t, 1, testutil.CollectAndCount(registry, "dnb_request_duration_seconds"))
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+
+	t.Run("Unit Test: Candidates are only recorded for searches and matches", func(t *testing.T) {
+		defer gock.Off()
+
+		registry := prometheus.NewRegistry()
+
+		recorder, err := prommetrics.New(registry)
+		assert.NoError(t, err)
+
+		client, _ := dnbclient.NewClient(
+			dnbclient.WithAPIToken("test_token"),
+			dnbclient.WithMetricsRecorder(recorder),
+		)
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.DataBlocksURL + "/804735132").
+			Reply(http.StatusOK).
+			JSON(map[string]any{"transactionDetail": map[string]string{"transactionID": "test_transactionID"}})
+
+		gock.New(dnbclient.BaseURLV1).
+			Get(dnbclient.CleanseMatchURL).
+			Reply(http.StatusOK).
+			JSON(map[string]any{
+				"transactionDetail":          map[string]string{"transactionID": "test_transactionID"},
+				"candidatesMatchedQuantity":  3,
+				"candidatesReturnedQuantity": 3,
+			})
+
+		_, err = client.GetDataBlocks(context.Background(), "804735132", dnbclient.Block(dnbclient.CompanyInfoBlock, 2))
+		assert.NoError(t, err)
+
+		assert.Equal(t, 0, testutil.CollectAndCount(registry, "dnb_candidates_returned"))
+
+		_, err = client.CleanseMatch(context.Background(), &dnbclient.CleanseMatchRequest{
+			Name:                 "test_name",
+			CountryISOAlpha2Code: "US",
+		})
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1, testutil.CollectAndCount(registry, "dnb_candidates_returned"))
+		assert.Equal(t, 2, testutil.CollectAndCount(registry, "dnb_request_duration_seconds"))
+
+		assert.True(t, gock.IsDone(), "Expected HTTP requests not made")
+	})
+}
@@ -0,0 +1,138 @@
+package dnbclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter throttles requests per Direct+ endpoint using a token bucket
+// for every endpoint that has a limit configured.
+type rateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+// bucket holds the tokens of a single endpoint. Tokens drop below zero when
+// callers are queued waiting for their turn.
+type bucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+// SetLimit configures the endpoint to allow requestsPerSecond requests with
+// bursts of up to burst requests. Setting the same limit again keeps the
+// current state of the bucket, a zero rate removes the limit.
+func (limiter *rateLimiter) SetLimit(endpoint string, requestsPerSecond float64, burst int) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	if requestsPerSecond <= 0 {
+		delete(limiter.buckets, endpoint)
+		return
+	}
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	current, ok := limiter.buckets[endpoint]
+	if ok && current.rate == requestsPerSecond && current.burst == float64(burst) {
+		return
+	}
+
+	limiter.buckets[endpoint] = &bucket{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   limiter.now(),
+	}
+}
+
+// Wait blocks until a request to the endpoint is allowed or the context is
+// done. Endpoints without a limit never block.
+func (limiter *rateLimiter) Wait(ctx context.Context, endpoint string) error {
+	limiter.mu.Lock()
+
+	bucket, ok := limiter.buckets[endpoint]
+	if !ok {
+		limiter.mu.Unlock()
+		return nil
+	}
+
+	bucket.refill(limiter.now())
+	bucket.tokens--
+	wait := bucket.delay()
+
+	limiter.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	err := sleep(ctx, wait)
+	if err != nil {
+		// Give the reserved token back so cancelled callers do not delay
+		// the ones queued after them.
+		limiter.mu.Lock()
+		bucket.tokens++
+		limiter.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// WaitTime returns how long a request to the endpoint would currently have
+// to wait before it is sent.
+func (limiter *rateLimiter) WaitTime(endpoint string) time.Duration {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	bucket, ok := limiter.buckets[endpoint]
+	if !ok {
+		return 0
+	}
+
+	bucket.refill(limiter.now())
+
+	if bucket.tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration((1 - bucket.tokens) / bucket.rate * float64(time.Second))
+}
+
+func (bucket *bucket) refill(now time.Time) {
+	elapsed := now.Sub(bucket.last).Seconds()
+	bucket.last = now
+
+	if elapsed <= 0 {
+		return
+	}
+
+	bucket.tokens += elapsed * bucket.rate
+	if bucket.tokens > bucket.burst {
+		bucket.tokens = bucket.burst
+	}
+}
+
+// delay returns the time until the bucket is out of debt.
+func (bucket *bucket) delay() time.Duration {
+	if bucket.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-bucket.tokens / bucket.rate * float64(time.Second))
+}
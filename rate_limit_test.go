@@ -0,0 +1,60 @@
+package dnbclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+
+	t.Run("Unit Test: Burst is allowed without waiting", func(t *testing.T) {
+		now := time.Now()
+
+		limiter := newRateLimiter()
+		limiter.now = func() time.Time { return now }
+		limiter.SetLimit(CriteriaSearchURL, 2, 2)
+
+		assert.NoError(t, limiter.Wait(context.Background(), CriteriaSearchURL))
+		assert.NoError(t, limiter.Wait(context.Background(), CriteriaSearchURL))
+		assert.Equal(t, 500*time.Millisecond, limiter.WaitTime(CriteriaSearchURL))
+
+		now = now.Add(time.Second)
+		assert.Equal(t, time.Duration(0), limiter.WaitTime(CriteriaSearchURL))
+	})
+
+	t.Run("Unit Test: Endpoints are limited independently", func(t *testing.T) {
+		limiter := newRateLimiter()
+		limiter.SetLimit(CriteriaSearchURL, 1, 1)
+
+		assert.NoError(t, limiter.Wait(context.Background(), CriteriaSearchURL))
+		assert.Greater(t, limiter.WaitTime(CriteriaSearchURL), time.Duration(0))
+		assert.Equal(t, time.Duration(0), limiter.WaitTime(ContactSearchURL))
+	})
+
+	t.Run("Unit Test: Waiting is cancelled with the context", func(t *testing.T) {
+		limiter := newRateLimiter()
+		limiter.SetLimit(TypeheadSearchURL, 0.1, 1)
+
+		assert.NoError(t, limiter.Wait(context.Background(), TypeheadSearchURL))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := limiter.Wait(ctx, TypeheadSearchURL)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.LessOrEqual(t, limiter.WaitTime(TypeheadSearchURL), 10*time.Second)
+	})
+
+	t.Run("Unit Test: Setting the same limit keeps the bucket", func(t *testing.T) {
+		limiter := newRateLimiter()
+		limiter.SetLimit(ContactSearchURL, 1, 1)
+
+		assert.NoError(t, limiter.Wait(context.Background(), ContactSearchURL))
+
+		limiter.SetLimit(ContactSearchURL, 1, 1)
+		assert.Greater(t, limiter.WaitTime(ContactSearchURL), time.Duration(0))
+	})
+}
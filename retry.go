@@ -0,0 +1,128 @@
+package dnbclient
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how the client retries requests that failed with a
+// transient error. The zero value disables retries.
+type RetryPolicy struct {
+	// Maximum number of attempts including the first one.
+	MaxAttempts int
+
+	// Backoff before the first retry, doubled on every following retry.
+	InitialBackoff time.Duration
+
+	// Upper limit for the backoff between two attempts, zero means no limit.
+	MaxBackoff time.Duration
+
+	// Fraction of the backoff, between 0 and 1, that is randomized.
+	Jitter float64
+
+	// Timeout of a single attempt, zero means the attempt is only limited
+	// by the request context.
+	AttemptTimeout time.Duration
+
+	// Status codes that are retried, defaults to RetryableStatusCodes.
+	RetryableStatusCodes []int
+}
+
+// Status codes retried when the policy does not list its own.
+var RetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// DefaultRetryPolicy retries throttled and unavailable responses up to three
+// times, suitable for long running batch jobs.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     30 * time.Second,
+	Jitter:         0.5,
+}
+
+func (policy RetryPolicy) isRetryableStatus(statusCode int) bool {
+	statusCodes := policy.RetryableStatusCodes
+	if statusCodes == nil {
+		statusCodes = RetryableStatusCodes
+	}
+
+	for _, code := range statusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the time to wait before the next attempt. A Retry-After
+// header sent by the API takes precedence over the computed backoff.
+func (policy RetryPolicy) backoff(attempt int, header http.Header) time.Duration {
+	if retryAfter, ok := parseRetryAfter(header, time.Now()); ok {
+		return retryAfter
+	}
+
+	backoff := policy.InitialBackoff
+	for i := 1; i < attempt && backoff < math.MaxInt64/2; i++ {
+		if policy.MaxBackoff > 0 && backoff >= policy.MaxBackoff {
+			break
+		}
+
+		backoff *= 2
+	}
+
+	if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+		backoff = policy.MaxBackoff
+	}
+
+	if policy.Jitter > 0 && backoff > 0 {
+		jitter := time.Duration(policy.Jitter * float64(backoff))
+		backoff = backoff - jitter + time.Duration(rand.Int64N(int64(jitter)+1))
+	}
+
+	return backoff
+}
+
+// parseRetryAfter reads the Retry-After header which holds either the number
+// of seconds to wait or an HTTP date.
+func parseRetryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait, true
+		}
+
+		return 0, true
+	}
+
+	return 0, false
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
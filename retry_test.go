@@ -0,0 +1,73 @@
+package dnbclient
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryBackoff(t *testing.T) {
+
+	t.Run("Unit Test: Backoff grows exponentially up to the maximum", func(t *testing.T) {
+		policy := RetryPolicy{
+			InitialBackoff: 100 * time.Millisecond,
+			MaxBackoff:     time.Second,
+		}
+
+		assert.Equal(t, 100*time.Millisecond, policy.backoff(1, nil))
+		assert.Equal(t, 200*time.Millisecond, policy.backoff(2, nil))
+		assert.Equal(t, 400*time.Millisecond, policy.backoff(3, nil))
+		assert.Equal(t, time.Second, policy.backoff(10, nil))
+	})
+
+	t.Run("Unit Test: Backoff without maximum keeps growing", func(t *testing.T) {
+		policy := RetryPolicy{InitialBackoff: time.Second}
+
+		assert.Equal(t, time.Second, policy.backoff(1, nil))
+		assert.Equal(t, 2*time.Second, policy.backoff(2, nil))
+		assert.Equal(t, 4*time.Second, policy.backoff(3, nil))
+		assert.Equal(t, 8*time.Second, policy.backoff(4, nil))
+		assert.Positive(t, policy.backoff(100, nil))
+	})
+
+	t.Run("Unit Test: Jitter keeps backoff within bounds", func(t *testing.T) {
+		policy := RetryPolicy{
+			InitialBackoff: 100 * time.Millisecond,
+			MaxBackoff:     time.Second,
+			Jitter:         0.5,
+		}
+
+		for i := 0; i < 100; i++ {
+			backoff := policy.backoff(2, nil)
+			assert.GreaterOrEqual(t, backoff, 100*time.Millisecond)
+			assert.LessOrEqual(t, backoff, 200*time.Millisecond)
+		}
+	})
+
+	t.Run("Unit Test: Retry-After takes precedence", func(t *testing.T) {
+		policy := RetryPolicy{InitialBackoff: time.Millisecond}
+
+		header := http.Header{}
+		header.Set("Retry-After", "3")
+
+		assert.Equal(t, 3*time.Second, policy.backoff(1, header))
+	})
+
+	t.Run("Unit Test: Retry-After as HTTP date", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		header := http.Header{}
+		header.Set("Retry-After", now.Add(5*time.Second).Format(http.TimeFormat))
+
+		wait, ok := parseRetryAfter(header, now)
+		assert.True(t, ok)
+		assert.Equal(t, 5*time.Second, wait)
+
+		header.Set("Retry-After", "invalid")
+
+		_, ok = parseRetryAfter(header, now)
+		assert.False(t, ok)
+	})
+}
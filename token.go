@@ -0,0 +1,124 @@
+package dnbclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Default amount of time before the token expiry at which the token
+// source will fetch a new token.
+const DefaultTokenRefreshWindow = 5 * time.Minute
+
+// Default amount of time a token request may take before it fails and the
+// next caller starts a new one.
+const DefaultTokenTimeout = 30 * time.Second
+
+// tokenFetcher requests a new API token and returns it together with
+// its lifetime.
+type tokenFetcher func(ctx context.Context) (string, time.Duration, error)
+
+// tokenSource caches the D&B Direct+ bearer token and refreshes it shortly
+// before it expires. Concurrent callers that need a refresh at the same
+// time share a single token request.
+type tokenSource struct {
+	mu            sync.Mutex
+	token         string
+	refreshAt     time.Time
+	refreshWindow time.Duration
+	timeout       time.Duration
+	inflight      *tokenCall
+	now           func() time.Time
+}
+
+// tokenCall is a token request in progress.
+type tokenCall struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+func newTokenSource() *tokenSource {
+	return &tokenSource{
+		refreshWindow: DefaultTokenRefreshWindow,
+		timeout:       DefaultTokenTimeout,
+		now:           time.Now,
+	}
+}
+
+// Token returns the cached token if it is still fresh, otherwise it calls
+// fetch to obtain a new one.
+func (source *tokenSource) Token(ctx context.Context, fetch tokenFetcher) (string, error) {
+	source.mu.Lock()
+
+	if source.token != "" && source.now().Before(source.refreshAt) {
+		token := source.token
+		source.mu.Unlock()
+
+		return token, nil
+	}
+
+	call := source.inflight
+	if call == nil {
+		call = &tokenCall{done: make(chan struct{})}
+		source.inflight = call
+
+		go source.refresh(ctx, call, fetch)
+	}
+
+	source.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// Set stores a token that expires after expiresIn.
+func (source *tokenSource) Set(token string, expiresIn time.Duration) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	source.store(token, expiresIn)
+}
+
+func (source *tokenSource) refresh(ctx context.Context, call *tokenCall, fetch tokenFetcher) {
+	// The refresh is shared by every waiting caller, so it must not be
+	// cancelled when the caller that started it goes away. It is bounded
+	// by its own timeout instead, a request that never returns would
+	// otherwise block every later refresh.
+	ctx = context.WithoutCancel(ctx)
+
+	if source.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, source.timeout)
+		defer cancel()
+	}
+
+	token, expiresIn, err := fetch(ctx)
+
+	source.mu.Lock()
+	if err == nil {
+		source.store(token, expiresIn)
+	}
+	source.inflight = nil
+	source.mu.Unlock()
+
+	call.token = token
+	call.err = err
+	close(call.done)
+}
+
+func (source *tokenSource) store(token string, expiresIn time.Duration) {
+	// Tokens that live shorter than the refresh window are refreshed at
+	// half of their lifetime instead of on every call.
+	window := source.refreshWindow
+	if window > expiresIn/2 {
+		window = expiresIn / 2
+	}
+
+	source.token = token
+	source.refreshAt = source.now().Add(expiresIn - window)
+}
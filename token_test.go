@@ -0,0 +1,63 @@
+package dnbclient
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenSource(t *testing.T) {
+
+	t.Run("Unit Test: Concurrent refreshes are collapsed", func(t *testing.T) {
+		source := newTokenSource()
+
+		var calls atomic.Int32
+		fetch := func(ctx context.Context) (string, time.Duration, error) {
+			calls.Add(1)
+			time.Sleep(10 * time.Millisecond)
+
+			return "test_token", time.Hour, nil
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				token, err := source.Token(context.Background(), fetch)
+				assert.NoError(t, err)
+				assert.Equal(t, "test_token", token)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("Unit Test: Token is refreshed before expiry", func(t *testing.T) {
+		now := time.Now()
+
+		source := newTokenSource()
+		source.now = func() time.Time { return now }
+		source.Set("old_token", time.Hour)
+
+		fetch := func(ctx context.Context) (string, time.Duration, error) {
+			return "new_token", time.Hour, nil
+		}
+
+		token, err := source.Token(context.Background(), fetch)
+		assert.NoError(t, err)
+		assert.Equal(t, "old_token", token)
+
+		now = now.Add(time.Hour - DefaultTokenRefreshWindow)
+
+		token, err = source.Token(context.Background(), fetch)
+		assert.NoError(t, err)
+		assert.Equal(t, "new_token", token)
+	})
+}
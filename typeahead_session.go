@@ -0,0 +1,202 @@
+package dnbclient
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/struki84/dnbclient/api_response"
+)
+
+// Debounce used when a typeahead session is created without one
+const DefaultTypeaheadDebounce = 250 * time.Millisecond
+
+// TypeaheadResult holds the results of a single search term of a typeahead session.
+type TypeaheadResult struct {
+	SearchTerm string
+	Results    *api_response.TypeheadSearch
+	Err        error
+}
+
+// TypeaheadSession runs typeahead searches for interactive input. Input is
+// debounced, in-flight requests for outdated input are cancelled and only the
+// results of the newest input are delivered.
+//
+//	session := client.NewTypeaheadSession(ctx, "US", 0)
+//	defer session.Close()
+//
+//	go func() {
+//		for result := range session.Results() {
+//			...
+//		}
+//	}()
+//
+//	session.Input("appl")
+type TypeaheadSession struct {
+	ctx         context.Context
+	client      *Client
+	countryCode string
+	options     []RequestOptions
+	debounce    time.Duration
+	results     chan TypeaheadResult
+
+	mu         sync.Mutex
+	generation int
+	timer      *time.Timer
+	cancel     context.CancelFunc
+	cache      map[string]*api_response.TypeheadSearch
+	closed     bool
+}
+
+// NewTypeaheadSession returns a typeahead session, the session has to be
+// closed once the input is done.
+//
+// # Parameters
+//
+// - ctx: context of the session, cancelling it cancels all requests
+//
+// - countryCode: the 2-letter ISO 3166-1 country code the session searches in
+//
+// - debounce: time the input has to stay unchanged before a request is sent, DefaultTypeaheadDebounce when zero
+//
+// - options: allows configuring the search with WithTypeheadSearchRequest
+//
+// # Returns
+//
+// - TypeaheadSession: typeahead session
+func (client *Client) NewTypeaheadSession(ctx context.Context, countryCode string, debounce time.Duration, options ...RequestOptions) *TypeaheadSession {
+	if debounce <= 0 {
+		debounce = DefaultTypeaheadDebounce
+	}
+
+	return &TypeaheadSession{
+		ctx:         ctx,
+		client:      client,
+		countryCode: countryCode,
+		options:     options,
+		debounce:    debounce,
+		results:     make(chan TypeaheadResult, 1),
+		cache:       map[string]*api_response.TypeheadSearch{},
+	}
+}
+
+// Results returns the channel the results are delivered on. Results that
+// were not read before newer results arrived are dropped.
+func (session *TypeaheadSession) Results() <-chan TypeaheadResult {
+	return session.results
+}
+
+// Input passes the current input to the session. Input shorter than 2 or
+// longer than 30 characters is not searched. Results of a previous search
+// are reused when the input was already searched, e.g. when characters are
+// deleted back to an earlier prefix. The results of a shorter prefix are not
+// narrowed down locally, the API also matches on tradestyle names which the
+// results do not hold.
+func (session *TypeaheadSession) Input(searchTerm string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if session.closed {
+		return
+	}
+
+	session.generation++
+	session.stop()
+
+	searchTerm = strings.TrimSpace(searchTerm)
+
+	length := utf8.RuneCountInString(searchTerm)
+	if length < TypeheadSearchTermMinLength || length > TypeheadSearchTermMaxLength {
+		return
+	}
+
+	if results, ok := session.cached(searchTerm); ok {
+		session.deliver(TypeaheadResult{SearchTerm: searchTerm, Results: results})
+
+		return
+	}
+
+	generation := session.generation
+	session.timer = time.AfterFunc(session.debounce, func() {
+		session.search(generation, searchTerm)
+	})
+}
+
+// Close cancels the pending search and closes the results channel.
+func (session *TypeaheadSession) Close() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if session.closed {
+		return
+	}
+
+	session.closed = true
+	session.stop()
+	close(session.results)
+}
+
+// stop cancels the debounce timer and the in-flight request.
+func (session *TypeaheadSession) stop() {
+	if session.timer != nil {
+		session.timer.Stop()
+		session.timer = nil
+	}
+
+	if session.cancel != nil {
+		session.cancel()
+		session.cancel = nil
+	}
+}
+
+func (session *TypeaheadSession) search(generation int, searchTerm string) {
+	session.mu.Lock()
+	if session.closed || generation != session.generation {
+		session.mu.Unlock()
+
+		return
+	}
+
+	ctx, cancel := context.WithCancel(session.ctx)
+	session.cancel = cancel
+	session.mu.Unlock()
+
+	defer cancel()
+
+	results, err := session.client.TypeheadSearch(ctx, searchTerm, session.countryCode, session.options...)
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if session.closed || generation != session.generation || errors.Is(err, context.Canceled) {
+		return
+	}
+
+	session.cancel = nil
+
+	if err == nil {
+		session.cache[strings.ToLower(searchTerm)] = results
+	}
+
+	session.deliver(TypeaheadResult{SearchTerm: searchTerm, Results: results, Err: err})
+}
+
+// deliver replaces undelivered results with the newest ones.
+func (session *TypeaheadSession) deliver(result TypeaheadResult) {
+	select {
+	case <-session.results:
+	default:
+	}
+
+	session.results <- result
+}
+
+// cached returns the results of an earlier search for the search term.
+func (session *TypeaheadSession) cached(searchTerm string) (*api_response.TypeheadSearch, bool) {
+	results, ok := session.cache[strings.ToLower(searchTerm)]
+
+	return results, ok
+}
@@ -0,0 +1,222 @@
+package dnbclient
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// Maximum page size of company searches
+	CompanySearchMaxPageSize = 1000
+
+	// Maximum page size of contact searches
+	ContactSearchMaxPageSize = 1000
+
+	// Minimum length of the typeahead search term
+	TypeheadSearchTermMinLength = 2
+
+	// Maximum length of the typeahead search term
+	TypeheadSearchTermMaxLength = 30
+
+	// Maximum number of candidates typeahead search returns
+	TypeheadSearchMaxCandidates = 50
+)
+
+// ValidationError describes a single field of a request that violates the
+// documented Direct+ limits.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (err *ValidationError) Error() string {
+	return err.Field + " " + err.Message
+}
+
+// validator collects the violations of a request.
+type validator struct {
+	errs []error
+}
+
+func (validator *validator) add(field string, format string, args ...any) {
+	validator.errs = append(validator.errs, &ValidationError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (validator *validator) err() error {
+	if len(validator.errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w, %w", ErrInvalidRequest, errors.Join(validator.errs...))
+}
+
+func (validator *validator) duns(field string, duns DUNS) {
+	if duns != "" && duns.Validate() != nil {
+		validator.add(field, "%q is not a 9 digit D-U-N-S number", duns)
+	}
+}
+
+func (validator *validator) country(field string, countryCode string) {
+	if countryCode == "" {
+		return
+	}
+
+	if len(countryCode) != 2 || strings.ToUpper(countryCode) != countryCode || strings.ContainsAny(countryCode, "0123456789") {
+		validator.add(field, "%q is not an ISO 3166-1 alpha-2 code", countryCode)
+	}
+}
+
+func (validator *validator) page(pageNumber int, pageSize int, maxPageSize int, maxResults int) {
+	if pageNumber < 0 {
+		validator.add("pageNumber", "can not be negative")
+	}
+
+	if pageSize < 0 || pageSize > maxPageSize {
+		validator.add("pageSize", "must be between 1 and %d", maxPageSize)
+	}
+
+	if pageNumber > 0 && pageSize > 0 && (pageNumber-1)*pageSize >= maxResults {
+		validator.add("pageNumber", "is past the %d results the endpoint returns", maxResults)
+	}
+}
+
+func (validator *validator) valueRange(field string, minimum int, maximum int) {
+	if minimum < 0 || maximum < 0 {
+		validator.add(field, "range can not be negative")
+	}
+
+	if maximum != 0 && minimum > maximum {
+		validator.add(field, "minimum %d is greater than maximum %d", minimum, maximum)
+	}
+}
+
+func (validator *validator) radius(field string, lat float64, lon float64, radius float64, unit string) {
+	if lat < -90 || lat > 90 {
+		validator.add(field+".lat", "%v is out of range", lat)
+	}
+
+	if lon < -180 || lon > 180 {
+		validator.add(field+".lon", "%v is out of range", lon)
+	}
+
+	if radius <= 0 {
+		validator.add(field+".radius", "must be positive")
+	}
+
+	if unit == "" {
+		validator.add(field+".unit", "is required")
+	} else if unit := strings.ToLower(unit); unit != "mi" && unit != "km" {
+		validator.add(field+".unit", "%q must be mi or km", unit)
+	}
+}
+
+// Validate checks the request against the documented Direct+ limits. Pages
+// are checked against the 10000 results of company list search, criteria
+// search only returns the first 1000.
+//
+// # Returns
+//
+// - error: ErrInvalidRequest joined with a ValidationError for every violated field
+func (request *CompanySearchRequest) Validate() error {
+	return request.validate(CompanyListMaxResults)
+}
+
+// validate checks the request with the result cap of the endpoint it is sent to.
+func (request *CompanySearchRequest) validate(maxResults int) error {
+	validator := &validator{}
+
+	validator.duns("duns", request.DUNS)
+
+	for _, duns := range request.DUNSList {
+		validator.duns("dunsList", duns)
+	}
+
+	if request.DUNS != "" && len(request.DUNSList) > 0 {
+		validator.add("dunsList", "can not be combined with duns")
+	}
+
+	validator.country("countryISOAlpha2Code", request.CountryISOAlpha2Code)
+	validator.page(request.PageNumber, request.PageSize, CompanySearchMaxPageSize, maxResults)
+
+	if request.NumberOfEmployees != nil {
+		validator.valueRange("numberOfEmployees", request.NumberOfEmployees.MinimumValue, request.NumberOfEmployees.MaximumValue)
+	}
+
+	if request.YearlyRevenue != nil {
+		validator.valueRange("yearlyRevenue", request.YearlyRevenue.MinimumValue, request.YearlyRevenue.MaximumValue)
+	}
+
+	if count := request.GlobalUltimateramilyTreeMembersCount; count != nil {
+		validator.valueRange("globalUltimateFamilyTreeMembersCount", count.MinimumValue, count.MaximumValue)
+	}
+
+	if radius := request.LocationRadius; radius != nil {
+		validator.radius("locationRadius", radius.Lat, radius.Lng, radius.Radius, radius.Unit)
+	}
+
+	return validator.err()
+}
+
+// Validate checks the request against the documented Direct+ limits.
+//
+// # Returns
+//
+// - error: ErrInvalidRequest joined with a ValidationError for every violated field
+func (request *TypeheadSearchRequest) Validate() error {
+	validator := &validator{}
+
+	length := utf8.RuneCountInString(strings.TrimSpace(request.SearchTerm))
+	if length < TypeheadSearchTermMinLength || length > TypeheadSearchTermMaxLength {
+		validator.add("searchTerm", "must be %d to %d characters", TypeheadSearchTermMinLength, TypeheadSearchTermMaxLength)
+	}
+
+	validator.country("countryISOAlpha2Code", request.CountryISOAlpha2Code)
+
+	if request.CandidateMaximumQuantity < 0 || request.CandidateMaximumQuantity > TypeheadSearchMaxCandidates {
+		validator.add("candidateMaximumQuantity", "must be between 1 and %d", TypeheadSearchMaxCandidates)
+	}
+
+	if request.RadiusDistance != 0 || request.RadiusUnit != "" {
+		if request.RadiusPostalCode == "" {
+			validator.radius("radius", request.RadiusLat, request.RadiusLon, request.RadiusDistance, request.RadiusUnit)
+		} else {
+			validator.radius("radius", 0, 0, request.RadiusDistance, request.RadiusUnit)
+		}
+	}
+
+	return validator.err()
+}
+
+// Validate checks the request against the documented Direct+ limits.
+//
+// # Returns
+//
+// - error: ErrInvalidRequest joined with a ValidationError for every violated field
+func (request *ContactSearchRequest) Validate() error {
+	validator := &validator{}
+
+	validator.duns("duns", request.Duns)
+	validator.country("countryISOAlpha2Code", request.CountryISOAlpha2Code)
+	validator.page(request.PageNumber, request.PageSize, ContactSearchMaxPageSize, ContactSearchMaxResults)
+
+	if request.View == ContactViewStandard && request.hasPremiumCriteria() {
+		validator.add("view", "standard search does not support contactID, contactEmail, hasDirectDial, usSicV4, mrcCode or industryCodes")
+	}
+
+	return validator.err()
+}
+
+// validate runs the request validation unless it was disabled with
+// WithoutValidation.
+func (client *Client) validate(validate func() error) error {
+	if client.skipValidation {
+		return nil
+	}
+
+	return validate()
+}